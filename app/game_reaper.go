@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GameReaper finalizes games that ran out of time without EndGame being called
+type GameReaper struct {
+	service  *GameService
+	interval time.Duration
+}
+
+func NewGameReaper(service *GameService, interval time.Duration) *GameReaper {
+	return &GameReaper{service: service, interval: interval}
+}
+
+// Run reaps expired games every interval until ctx is cancelled
+func (r *GameReaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			count, err := r.Reap(now)
+			if err != nil {
+				fmt.Println("GameReaper", "Reap", err)
+			}
+			if count > 0 {
+				fmt.Println("GameReaper", "expired games", count)
+			}
+		}
+	}
+}
+
+// Reap finalizes every game whose duration ran out before now without it
+// being finalized. Each game is expired, verified and published through
+// GameService.ExpireGame.
+func (r *GameReaper) Reap(now time.Time) (int, error) {
+	games, err := r.service.repo.GetExpiredGames(now)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, game := range games {
+		if game.IsOpen() && !game.IsExpired(now) {
+			continue
+		}
+		finalized, err := r.service.ExpireGame(game.ID, now)
+		if err != nil {
+			fmt.Println("GameReaper", "ExpireGame", game.ID, err)
+			continue
+		}
+		if finalized {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -1,175 +1,649 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
 	"github.com/bernardbaker/qiba.core/mocks"
 	"github.com/bernardbaker/qiba.core/ports"
+	"github.com/bernardbaker/qiba.core/receipt"
 )
 
 type GameService struct {
 	repo            ports.GameRepository
+	eventRepo       ports.GameEventRepository
 	userRepo        ports.UserRepository
 	leaderboardRepo ports.LeaderboardRepository
+	reviewRepo      ports.ReviewRepository
 	encrypter       ports.Encrypter
+	signer          ports.ReceiptSigner
+	rules           *domain.RuleBook
+	modes           *domain.ModeRegistry
+	// How long after a game ends the next free play is available
+	replayDelay time.Duration
+	// gameLocks serialise read-modify-write updates of a game, and play
+	// allowance checks of a user
+	gameLocks *[gameLockStripes]sync.Mutex
 }
 
-func NewGameService(repo ports.GameRepository, userRepo ports.UserRepository, leaderboardRepo ports.LeaderboardRepository, encrypter ports.Encrypter) *GameService {
-	return &GameService{repo: repo, userRepo: userRepo, leaderboardRepo: leaderboardRepo, encrypter: encrypter}
+const gameLockStripes = 64
+
+func NewGameService(repo ports.GameRepository, eventRepo ports.GameEventRepository, userRepo ports.UserRepository, leaderboardRepo ports.LeaderboardRepository, reviewRepo ports.ReviewRepository, encrypter ports.Encrypter, signer ports.ReceiptSigner, rules *domain.RuleBook, modes *domain.ModeRegistry, replayDelay time.Duration) *GameService {
+	return &GameService{repo: repo, eventRepo: eventRepo, userRepo: userRepo, leaderboardRepo: leaderboardRepo, reviewRepo: reviewRepo, encrypter: encrypter, signer: signer, rules: rules, modes: modes, replayDelay: replayDelay, gameLocks: new([gameLockStripes]sync.Mutex)}
 }
 
-func (s *GameService) StartGame(userId string, user domain.User) (string, string, string, error) {
-	game := domain.NewGame(userId)
-	err := s.repo.SaveGame(game)
+// StartGame creates a game of the named mode, an empty mode is the default
+// timed game. Modes that cost plays use up the user's free play or, failing
+// that, a bonus game; ErrNoPlaysLeft is returned when neither is left. The
+// game's ticket is returned encrypted together with its HMAC.
+func (s *GameService) StartGame(userId string, user domain.User, modeName string) (string, string, *domain.Game, error) {
+	mode, err := s.modes.Mode(modeName)
+	if err != nil {
+		return "", "", nil, err
+	}
+	rules, err := s.rules.ForMode(mode.Rules)
 	if err != nil {
-		return "", "", "", err
+		return "", "", nil, err
 	}
-	var possibleNewUser *domain.User
 
-	u, getErr := s.userRepo.Get(userId)
-	if getErr != nil {
-		fmt.Println("StartGame", getErr)
-		possibleNewUser = domain.NewUser(user)
+	// Checking and using up plays must not interleave for one user
+	defer s.lockUser(userId)()
+
+	if existing, getErr := s.userRepo.Get(userId); getErr == nil {
+		if existing.IsBanned() {
+			return "", "", nil, domain.ErrUserBanned
+		}
 	} else {
-		possibleNewUser = u
+		fmt.Println("StartGame", getErr)
+		if saveErr := s.userRepo.Save(domain.NewUser(user)); saveErr != nil {
+			fmt.Println("error saving user: ", saveErr)
+			return "", "", nil, saveErr
+		}
 	}
-	saveErr := s.userRepo.Update(possibleNewUser)
-	if saveErr != nil {
-		fmt.Println("error saving user: ", saveErr)
-		return "", "", "", saveErr
+
+	game := domain.NewGame(userId, mode, rules)
+	if mode.Daily {
+		if err := s.checkDailyAttempt(userId, mode, game.StartTime); err != nil {
+			return "", "", nil, err
+		}
+		game.Seed = domain.DailySeed(os.Getenv("DAILY_CHALLENGE_SALT"), game.StartTime)
+		s.CreateLeaderboard(mode.LeaderboardFor(game.StartTime), false)
 	}
+
 	// Encrypt game data and generate HMAC
-	// encryptedData, hmac, err := s.encrypter.EncryptGameData(game.ObjectSeq)
-	// if err != nil {
-	// 	return "", "", "", err
-	// }
+	encryptedData, hmac, err := s.encrypter.EncryptGameData(game.Ticket())
+	if err != nil {
+		return "", "", nil, err
+	}
 
-	// count = 1
+	usedBonus := false
+	if mode.AllowanceCost > 0 {
+		usedBonus, err = s.usePlay(userId, game.StartTime)
+		if err != nil {
+			return "", "", nil, err
+		}
+	}
 
-	return "", "", game.ID, nil
+	err = s.repo.SaveGame(game)
+	if err != nil {
+		if usedBonus {
+			// Hand back the bonus game the failed start used
+			if _, refundErr := s.userRepo.AdjustBonusGames(userId, 1); refundErr != nil {
+				fmt.Println("StartGame", "refund bonus game", userId, refundErr)
+			}
+		}
+		return "", "", nil, err
+	}
+	return encryptedData, hmac, game, nil
 }
 
-func (s *GameService) Spawn(gameID string) (string, error) {
-	game, err := s.repo.GetGame(gameID)
+// CheckGameData verifies game data a client echoes back was issued by
+// StartGame for gameID
+func (s *GameService) CheckGameData(gameID, encryptedData, hmac string) error {
+	var ticket domain.GameTicket
+	if err := s.encrypter.DecryptGameData(encryptedData, hmac, &ticket); err != nil {
+		return err
+	}
+	if ticket.GameID != gameID {
+		return fmt.Errorf("%w: issued for another game", ports.ErrGameDataTampered)
+	}
+	return nil
+}
+
+// usePlay uses up the user's free play when the replay delay has passed since
+// their last game, and a bonus game otherwise. The user repository applies
+// each change conditionally, so concurrent starts cannot share a play. It
+// reports whether a bonus game was used.
+func (s *GameService) usePlay(userId string, now time.Time) (bool, error) {
+	free, err := s.freePlayAvailable(userId, now)
 	if err != nil {
-		return "", err
+		return false, err
 	}
+	if free {
+		claimed, err := s.userRepo.ClaimPlay(userId, now, now.Add(-s.replayDelay))
+		if err != nil {
+			return false, err
+		}
+		if claimed {
+			return false, nil
+		}
+	}
+	used, err := s.userRepo.AdjustBonusGames(userId, -1)
+	if err != nil {
+		return false, err
+	}
+	if !used {
+		return false, domain.ErrNoPlaysLeft
+	}
+	return true, nil
+}
 
-	game.GenerateObjectSequence()
+// freePlayAvailable reports whether none of the user's games ended within the
+// replay delay before now
+func (s *GameService) freePlayAvailable(userId string, now time.Time) (bool, error) {
+	games, err := s.allowanceGames(userId)
+	if err != nil {
+		return false, err
+	}
+	since := now.Add(-s.replayDelay)
+	for _, game := range games {
+		if game.EndTime.After(since) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
 
-	s.repo.SaveGame(game)
+// riskThreshold is the anti-cheat risk score from which a game is held for
+// review
+func (s *GameService) riskThreshold() float64 {
+	threshold, err := strconv.ParseFloat(os.Getenv("ANTI_CHEAT_RISK_THRESHOLD"), 64)
+	if err != nil || threshold <= 0 {
+		return domain.DefaultRiskThreshold
+	}
+	return threshold
+}
 
-	json, err := json.Marshal(game.ObjectSeq[len(game.ObjectSeq)-1])
+// allowanceGames returns the user's games that used up plays. Practice games
+// and other modes that cost nothing are left out.
+func (s *GameService) allowanceGames(userId string) ([]*domain.Game, error) {
+	games, err := s.repo.GetGamesByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+	counted := make([]*domain.Game, 0, len(games))
+	for _, game := range games {
+		if game.Practice {
+			continue
+		}
+		// Games from before modes were timed games
+		if mode, err := s.modes.Mode(game.Mode); err == nil && mode.AllowanceCost == 0 {
+			continue
+		}
+		counted = append(counted, game)
+	}
+	return counted, nil
+}
 
+// checkDailyAttempt returns ErrDailyChallengePlayed when the user already
+// started the daily challenge on now's day
+func (s *GameService) checkDailyAttempt(userId string, mode *domain.GameMode, now time.Time) error {
+	day := domain.Day(now)
+	page, err := s.repo.ListGames(domain.GameQuery{
+		UserID: userId,
+		Mode:   mode.Name,
+		From:   day,
+		To:     day.AddDate(0, 0, 1),
+		Limit:  1,
+	})
 	if err != nil {
-		return "", err
+		return err
 	}
+	if len(page.Games) > 0 {
+		return domain.ErrDailyChallengePlayed
+	}
+	return nil
+}
+
+// lockGame locks gameID and returns the matching unlock function
+func (s *GameService) lockGame(gameID string) func() {
+	return s.lock("game:" + gameID)
+}
+
+// lockUser locks userId and returns the matching unlock function
+func (s *GameService) lockUser(userId string) func() {
+	return s.lock("user:" + userId)
+}
 
-	return string(json), nil
+func (s *GameService) lock(key string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	mutex := &s.gameLocks[h.Sum32()%gameLockStripes]
+	mutex.Lock()
+	return mutex.Unlock
 }
 
-func (s *GameService) Tap(gameID, objectID string, timestamp time.Time) (bool, error) {
+// Spawn appends the next object of the game's sequence and returns it. It
+// returns ErrNoObjectsLeft once the next object would appear after the game
+// expires.
+func (s *GameService) Spawn(gameID string) (*domain.GameObject, error) {
+	defer s.lockGame(gameID)()
+
 	game, err := s.repo.GetGame(gameID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	// Verify object ID and timestamp
-	for _, obj := range game.ObjectSeq {
-		if obj.ID == objectID && time.Now().After(obj.Timestamp) {
-			if obj.Type == "a" {
-				game.Score++
-			} else {
-				game.Score = game.Score - 5
-			}
-			return true, s.repo.UpdateGame(game)
+	if err := game.CheckPlayable(time.Now()); err != nil {
+		if updateErr := s.repo.UpdateGame(game); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, err
+	}
+
+	rules, err := s.gameRules(game)
+	if err != nil {
+		return nil, err
+	}
+
+	spawned, err := game.SpawnObject(rules)
+	if err != nil {
+		return nil, err
+	}
+	obj := *spawned
+	game.Start()
+
+	if err := s.repo.SaveGame(game); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(domain.NewSpawnEvent(game, &obj, time.Now()))
+	return &obj, nil
+}
+
+// StreamSpawns spawns the game's objects on the server clock, calling send
+// as each object becomes visible, until the game's duration or mistakes run
+// out or ctx is cancelled. A game that runs out is ended and finalized the
+// way EndGame would, and returned with its result.
+func (s *GameService) StreamSpawns(ctx context.Context, gameID string, send func(*domain.GameObject) error) (*domain.Game, error) {
+	game, err := s.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := game.ExpiresAt
+
+	for {
+		obj, err := s.Spawn(gameID)
+		if errors.Is(err, domain.ErrNoMistakesLeft) {
+			return s.EndGame(gameID)
+		}
+		if errors.Is(err, domain.ErrNoObjectsLeft) || errors.Is(err, domain.ErrGameExpired) || errors.Is(err, domain.ErrGameEnded) {
+			break
+		}
+		if err != nil {
+			return nil, err
 		}
+		if err := sleepUntil(ctx, obj.Timestamp); err != nil {
+			return nil, err
+		}
+		if err := send(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sleepUntil(ctx, expiresAt); err != nil {
+		return nil, err
 	}
-	return false, nil
+	// The stream's clock decides when the game is over, so it ends at
+	// ExpiresAt without waiting out the grace period. A game the reaper or
+	// EndGame finalized meanwhile is returned as it is.
+	return s.EndGame(gameID)
+}
+
+// sleepUntil waits for t or for ctx to be cancelled
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (s *GameService) GetGame(gameID string) (*domain.Game, error) {
+	return s.repo.GetGame(gameID)
 }
 
-// TODO Return the game object instead
-func (s *GameService) EndGame(gameID string) (int32, error) {
+// ListGames returns one page of a user's game history
+func (s *GameService) ListGames(query domain.GameQuery) (*domain.GamePage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	return s.repo.ListGames(query)
+}
+
+// CheckGameOwner returns domain.ErrNotGameOwner unless userID started the game
+func (s *GameService) CheckGameOwner(gameID, userID string) error {
 	game, err := s.repo.GetGame(gameID)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	fmt.Println("EndGame with game ID", game.ID)
-	game.EndTime = time.Now().UTC()
-	updateError := s.repo.UpdateGame(game)
-	if updateError != nil {
-		fmt.Println("EndGame", "updateError = s.repo.UpdateGame(game)", updateError)
-		game.Score = 0
+	return game.CheckOwner(userID)
+}
+
+// GameOwner returns the user who started the game. A user missing from the
+// repository is returned with only their ID.
+func (s *GameService) GameOwner(game *domain.Game) (domain.User, error) {
+	if owner, err := s.userRepo.Get(game.UserID); err == nil {
+		return *owner, nil
 	}
-	// Reset playsLeft counter
-	return game.Score, nil
+	userId, err := strconv.ParseInt(game.UserID, 10, 64)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("game %s has no owner: %w", game.ID, err)
+	}
+	return domain.User{UserId: userId}, nil
 }
 
-func (s *GameService) CanPlay(user domain.User) bool {
-	fmt.Println("CanPlay", "user.UserId", user.UserId)
-	// get all games for user
-	games, err := s.repo.GetGamesByUser(strconv.FormatInt(user.UserId, 10))
+// GetGameEvents returns the event log of a game
+func (s *GameService) GetGameEvents(gameID string) ([]*domain.GameEvent, error) {
+	if _, err := s.repo.GetGame(gameID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetEvents(gameID)
+}
+
+// recordEvent appends to the game's event log. A failed append does not fail
+// the game, the gap shows when the log is replayed.
+func (s *GameService) recordEvent(event *domain.GameEvent) {
+	if err := s.eventRepo.AppendEvent(event); err != nil {
+		fmt.Println("GameService", "AppendEvent", event.GameID, event.Type, err)
+	}
+}
+
+// gameRules returns the rules version a game was created under. Games from
+// before versioned rules have none and use GeneratorV1.
+func (s *GameService) gameRules(game *domain.Game) (*domain.Rules, error) {
+	if game.RulesVersion == "" {
+		return nil, nil
+	}
+	return s.rules.Version(game.RulesVersion)
+}
+
+// Tap scores a tap on objectID made at timestamp. Each object scores at most
+// once, repeated taps are reported as duplicates. The reaction time is
+// measured from the object spawning to timestamp.
+func (s *GameService) Tap(gameID, objectID string, timestamp time.Time) (domain.TapOutcome, error) {
+	defer s.lockGame(gameID)()
+
+	game, err := s.repo.GetGame(gameID)
 	if err != nil {
-		return false
+		return domain.TapOutcome{}, err
 	}
-	if len(games) == 0 {
-		fmt.Println("")
-		fmt.Println("CanPlay", "len(games) == 0", len(games))
-		fmt.Println("")
-		return true
-	} else {
-		// debugging
-		fmt.Println("")
-		getUser, err := s.userRepo.Get(strconv.FormatInt(user.UserId, 10))
-		if err != nil {
-			fmt.Println("CanPlay", "err = s.userRepo.Get(strconv.FormatInt(user.UserId, 10))", err)
-			fmt.Println(err)
+
+	now := time.Now()
+	if err := game.CheckPlayable(now); err != nil {
+		if updateErr := s.repo.UpdateGame(game); updateErr != nil {
+			return domain.TapOutcome{}, updateErr
 		}
+		s.recordEvent(domain.NewRejectedTapEvent(game, objectID, timestamp, err, now))
+		return domain.TapOutcome{}, err
+	}
 
-		if getUser.BonusGames > 0 {
-			fmt.Println("CanPlay", "getUser..BonusGames > 0", getUser.BonusGames)
-			getUser.BonusGames--
-			fmt.Println("CanPlay", "getUser..BonusGames is now", getUser.BonusGames)
-			err = s.userRepo.Save(getUser)
-			if err != nil {
-				fmt.Println("CanPlay", "err = s.userRepo.Save(getUser)", err)
-				fmt.Println(err)
-			}
+	rules, err := s.gameRules(game)
+	if err != nil {
+		return domain.TapOutcome{}, err
+	}
+
+	score, streak, mistakes := game.Score, game.Streak, game.Mistakes
+	outcome := game.Tap(objectID, timestamp, now, rules)
+	s.recordEvent(domain.NewTapEvent(game, objectID, timestamp, outcome))
+	// Rejected taps that changed nothing need not be stored, misses still
+	// break the streak and count as mistakes
+	if outcome.Result != domain.TapAccepted && game.Score == score && game.Streak == streak && game.Mistakes == mistakes {
+		return outcome, nil
+	}
+	return outcome, s.repo.UpdateGame(game)
+}
+
+// EndGame finalizes the game exactly once. It ends the game, verifies its
+// score by replaying the game's event log, signs a receipt for a verified
+// score and posts it to the game's leaderboard under the game's owner. The
+// result is stored on the game, and ending the game again returns the game
+// with its stored result without posting the score again.
+func (s *GameService) EndGame(gameID string) (*domain.Game, error) {
+	defer s.lockGame(gameID)()
+
+	game, err := s.repo.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("EndGame with game ID", game.ID)
+	if game.IsFinalized() {
+		return game, nil
+	}
+
+	// A game ended by an earlier call that failed to publish its result is
+	// only published
+	if game.Status != domain.GameStatusEnded {
+		if err := game.End(time.Now().UTC()); err != nil {
+			return nil, err
 		}
+		s.verifyScore(game)
+		updateError := s.repo.UpdateGame(game)
+		if updateError != nil {
+			fmt.Println("EndGame", "updateError = s.repo.UpdateGame(game)", updateError)
+			return nil, updateError
+		}
+		s.recordEvent(domain.NewFinishEvent(game, time.Now()))
+	}
+	return s.finalize(game)
+}
 
-		if getUser.BonusGames > 0 {
-			return true
+// ExpireGame finalizes a game that ran out of time without EndGame being
+// called, and reports whether it did. The game is expired, verified and
+// published the way EndGame would and under the same lock, so it never
+// overwrites a game EndGame finalized meanwhile. Finalized games and games
+// with time left are left alone.
+func (s *GameService) ExpireGame(gameID string, now time.Time) (bool, error) {
+	defer s.lockGame(gameID)()
+
+	game, err := s.repo.GetGame(gameID)
+	if err != nil {
+		return false, err
+	}
+	if game.IsFinalized() || (game.IsOpen() && !game.IsExpired(now)) {
+		return false, nil
+	}
+
+	// A game that was expired and verified by an earlier call that failed to
+	// publish its result is only published
+	if game.Status != domain.GameStatusEnded && game.Verification.Status == "" {
+		game.Expire()
+		s.verifyScore(game)
+		if err := s.repo.UpdateGame(game); err != nil {
+			return false, err
 		}
+		s.recordEvent(domain.NewFinishEvent(game, now))
+	}
+	if _, err := s.finalize(game); err != nil {
+		return false, err
+	}
+	return true, nil
+}
 
-		// check if the last game was played more than 24 hours ago
-		lastGame := games[0]
-		fmt.Println("")
-		fmt.Println("lastGame")
-		fmt.Println(lastGame)
-		fmt.Println("")
-		delay, replayErr := strconv.ParseInt(os.Getenv("REPLAY_GAME_DELAY_IN_MINUTES"), 10, 32)
+// finalize publishes the finished game's result and stores it on the game
+func (s *GameService) finalize(game *domain.Game) (*domain.Game, error) {
+	result, err := s.publishResult(game)
+	if err != nil {
+		return nil, err
+	}
+	game.Result = result
+	if err := s.repo.UpdateGame(game); err != nil {
+		fmt.Println("GameService", "failed to store result", game.ID, err)
+		game.Result = nil
+		return nil, err
+	}
+	return game, nil
+}
+
+// publishResult signs a receipt for the ended game's score and posts it to
+// the game's leaderboard when it was verified. Boards keep one entry per
+// game, so publishing again after a failure does not count the score twice.
+// A leaderboard score the anti-cheat analyzer finds too risky is held for
+// review instead.
+func (s *GameService) publishResult(game *domain.Game) (*domain.GameResult, error) {
+	result := &domain.GameResult{Score: game.Score, Verified: game.Verification.Verified(), FinalizedAt: time.Now().UTC()}
+	if !result.Verified {
+		fmt.Println("EndGame", "score withheld from leaderboard", game.ID, game.Verification.Detail)
+		return result, nil
+	}
+	board := s.Leaderboard(game)
+	if board != "" {
+		held, err := s.holdForReview(game, board)
 		if err != nil {
-			fmt.Println("replay game delay error", replayErr)
-		}
-		fmt.Println("delay", delay)
-		fmt.Println("lastGame.EndTime.UTC()", lastGame.EndTime.UTC())
-		timeReference := lastGame.EndTime.UTC().Add(time.Duration(delay) * time.Minute)
-		fmt.Println("r", timeReference)
-		// use server timestamp instead of what is sent
-		time := time.Now().UTC()
-		latestGame := time.After(timeReference)
-		fmt.Println("c", time.UTC())
-
-		fmt.Println("CanPlay", "return latestGame", latestGame)
-		fmt.Println("")
-		return latestGame
+			return nil, err
+		}
+		if held {
+			result.UnderReview = true
+			return result, nil
+		}
+	}
+
+	if err := s.publish(game, board, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// publish signs result's receipt and posts the game's score to board, if
+// there is one
+func (s *GameService) publish(game *domain.Game, board string, result *domain.GameResult) error {
+	// A receipt that can't be signed is left out rather than failing the game
+	token, err := s.Receipt(game)
+	if err != nil {
+		fmt.Println("EndGame", "failed to sign receipt", game.ID, err)
+	}
+	result.Receipt = token
+
+	if board == "" {
+		return nil
+	}
+	owner, err := s.GameOwner(game)
+	if err != nil {
+		return err
+	}
+	entry := domain.NewLeaderboardObject(owner, game.Score)
+	entry.GameID = game.ID
+	if _, err := s.addEntry(board, entry); err != nil {
+		return err
+	}
+	result.Leaderboard = board
+	return nil
+}
+
+// holdForReview assesses the ended game's cheating risk and puts it in the
+// review queue when the risk reaches the threshold. Games whose events or
+// history can't be read are published, the replay already verified them.
+func (s *GameService) holdForReview(game *domain.Game, board string) (bool, error) {
+	events, err := s.eventRepo.GetEvents(game.ID)
+	if err != nil {
+		fmt.Println("EndGame", "risk assessment skipped", game.ID, err)
+		return false, nil
+	}
+	var history []*domain.Game
+	page, err := s.repo.ListGames(domain.GameQuery{UserID: game.UserID, Mode: game.Mode, Limit: domain.MaxGamePageSize})
+	if err != nil {
+		fmt.Println("EndGame", "risk assessment without history", game.ID, err)
+	} else {
+		history = page.Games
+	}
+	risk := domain.AnalyzeGame(game, events, history)
+	game.Risk = &risk
+	if !risk.Exceeds(s.riskThreshold()) {
+		return false, nil
+	}
+	fmt.Println("EndGame", "score held for review", game.ID, "risk", risk.Score)
+	if err := s.reviewRepo.SaveReview(domain.NewGameReview(game, board, risk, time.Now().UTC())); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyScore replays the game's event log against its score. A game that
+// cannot be replayed is recorded as a mismatch so its score is withheld.
+func (s *GameService) verifyScore(game *domain.Game) {
+	rules, err := s.gameRules(game)
+	var events []*domain.GameEvent
+	if err == nil {
+		events, err = s.eventRepo.GetEvents(game.ID)
+	}
+	if err != nil {
+		game.Verification = domain.ScoreVerification{Status: domain.VerificationMismatch, Detail: err.Error()}
+	} else {
+		game.Verify(events, rules)
+	}
+	if !game.Verification.Verified() {
+		fmt.Println("GameService", "EndGame", game.ID, "score", game.Score, game.Verification.Detail)
+	}
+}
+
+// Receipt signs a receipt of the ended game's score. Only games whose score
+// was verified get one.
+func (s *GameService) Receipt(game *domain.Game) (string, error) {
+	if game.IsOpen() {
+		return "", domain.ErrGameNotEnded
+	}
+	if !game.Verification.Verified() {
+		return "", domain.ErrScoreUnverified
+	}
+	return s.signer.Sign(receipt.Receipt{
+		UserID:    game.UserID,
+		GameID:    game.ID,
+		Score:     game.Score,
+		Mode:      game.Mode,
+		Practice:  game.Practice,
+		StartTime: game.StartTime,
+		EndTime:   game.EndTime,
+		IssuedAt:  time.Now().UTC(),
+	})
+}
+
+// ReceiptKeys lists the public keys receipts can be verified with
+func (s *GameService) ReceiptKeys() []receipt.PublicKey {
+	return s.signer.PublicKeys()
+}
+
+// CanPlay reports whether the user has a free play or a bonus game left. It
+// does not use the play up, StartGame does.
+func (s *GameService) CanPlay(user domain.User) bool {
+	fmt.Println("CanPlay", "user.UserId", user.UserId)
+	userId := strconv.FormatInt(user.UserId, 10)
+	now := time.Now()
+	free, err := s.freePlayAvailable(userId, now)
+	if err != nil {
+		fmt.Println("CanPlay", "freePlayAvailable", err)
+		return false
+	}
+	u, err := s.userRepo.Get(userId)
+	if err != nil {
+		// New users have not played yet
+		return free
 	}
+	if free && !u.LastPlayAt.After(now.Add(-s.replayDelay)) {
+		return true
+	}
+	return u.BonusGames > 0
 }
 
 func (s *GameService) AddBonusGame(user domain.User) (bool, error) {
@@ -221,7 +695,7 @@ func (s *GameService) GetBonusGames(user domain.User) (string, bool) {
 	u, err := s.userRepo.Get(userId)
 	if err != nil {
 		fmt.Println("GetBonusGames u, err := s.userRepo.Get(userId)", err)
-		return string(0), false
+		return "0", false
 	}
 	count := strconv.FormatInt(u.BonusGames, 10)
 	fmt.Println("count", count)
@@ -257,7 +731,11 @@ func (s *GameService) CreateLeaderboard(name string, prepopulate bool) {
 	}
 }
 
-func (s *GameService) GetLeaderboard(name string, user *domain.User) (string, string, error) {
+// GetLeaderboard returns the board's top 100 and, when user is not among
+// them, user's own scores. viewer is the user whose identity was verified,
+// nil for anonymous callers and users who only claimed who they are; only a
+// verified quarantined user is shown their own scores.
+func (s *GameService) GetLeaderboard(name string, user *domain.User, viewer *domain.User) (string, string, error) {
 	table, err := s.leaderboardRepo.GetLeaderboard(name)
 	// if table is nil, create a new one
 	if table == nil {
@@ -270,8 +748,15 @@ func (s *GameService) GetLeaderboard(name string, user *domain.User) (string, st
 
 	fmt.Println("table", &table)
 
+	// Scores of moderated users are only shown to themselves
+	moderated, err := s.userRepo.ListModeratedUsers()
+	if err != nil {
+		return "", "", err
+	}
+
 	// Using the sorted version
-	sortedTotals := domain.GroupAndTotalScoresByUserSorted(table)
+	quarantine := domain.NewQuarantine(moderated)
+	sortedTotals := domain.GroupAndTotalScoresByUserSorted(table, quarantine, viewer)
 	fmt.Println("sortedTotals", sortedTotals)
 	for _, userScore := range sortedTotals {
 		fmt.Printf("User: %s, Total Score: %d\n",
@@ -312,7 +797,7 @@ func (s *GameService) GetLeaderboard(name string, user *domain.User) (string, st
 		fmt.Println("GetLeaderboard didn't find user && user != nil")
 		fmt.Println("")
 		for _, entry := range table.Entries {
-			if entry.User.UserId == user.UserId {
+			if entry.User.UserId == user.UserId && !quarantine.Hides(entry.User, viewer) {
 				usersScore = append(usersScore, domain.LeaderboardEntry{
 					Username: entry.User.Username,
 					Score:    entry.Score,
@@ -342,14 +827,146 @@ func (s *GameService) GetLeaderboard(name string, user *domain.User) (string, st
 	}
 }
 
-func (s *GameService) AddToLeaderboard(user domain.User, score int32) (*domain.Table, error) {
+// ListReviews returns up to limit reviews with status, oldest first. Only
+// moderators may list reviews.
+func (s *GameService) ListReviews(moderatorId string, status domain.ReviewStatus, limit int) ([]*domain.GameReview, error) {
+	if !s.isModerator(moderatorId) {
+		return nil, domain.ErrNotModerator
+	}
+	return s.reviewRepo.ListReviews(status, limit)
+}
+
+// GetReview returns the review of a held game
+func (s *GameService) GetReview(gameID string) (*domain.GameReview, error) {
+	return s.reviewRepo.GetReview(gameID)
+}
+
+// ApproveReview publishes a score held for review the way EndGame publishes
+// a fair one: its receipt is signed, the score is posted to the board it was
+// held from and the game's result is updated.
+func (s *GameService) ApproveReview(moderatorId, gameID string) (*domain.Game, error) {
+	return s.decideReview(moderatorId, gameID, domain.ReviewApproved)
+}
+
+// RejectReview keeps a score held for review off the leaderboard for good
+func (s *GameService) RejectReview(moderatorId, gameID string) (*domain.Game, error) {
+	return s.decideReview(moderatorId, gameID, domain.ReviewRejected)
+}
+
+// decideReview records a moderator's verdict on a held game under the game's
+// lock. The game's result is stored before the review, so a verdict whose
+// review failed to save can be given again without publishing twice.
+func (s *GameService) decideReview(moderatorId, gameID string, verdict domain.ReviewStatus) (*domain.Game, error) {
+	if !s.isModerator(moderatorId) {
+		return nil, domain.ErrNotModerator
+	}
+	defer s.lockGame(gameID)()
+
+	review, err := s.reviewRepo.GetReview(gameID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if err := review.Decide(verdict, moderatorId, now); err != nil {
+		return nil, err
+	}
+	game, err := s.repo.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game.Result == nil {
+		return nil, fmt.Errorf("%w: game %s has no result", domain.ErrGameNotEnded, gameID)
+	}
+
+	if !game.Result.UnderReview && game.Result.Rejected != (verdict == domain.ReviewRejected) {
+		return nil, fmt.Errorf("%w: game %s already has the opposite verdict", domain.ErrReviewDecided, gameID)
+	}
+
+	if game.Result.UnderReview {
+		result := *game.Result
+		result.UnderReview = false
+		result.FinalizedAt = now
+		if verdict == domain.ReviewApproved {
+			if err := s.publish(game, review.Leaderboard, &result); err != nil {
+				return nil, err
+			}
+		} else {
+			result.Rejected = true
+		}
+		previous := game.Result
+		game.Result = &result
+		if err := s.repo.UpdateGame(game); err != nil {
+			game.Result = previous
+			return nil, err
+		}
+	}
+	if err := s.reviewRepo.SaveReview(review); err != nil {
+		return nil, err
+	}
+	fmt.Println("GameService", "review", gameID, verdict, "by", moderatorId)
+	return game, nil
+}
+
+// ModerateUser sets the moderation status of userId. Only the users listed
+// in MODERATOR_USER_IDS may moderate others.
+func (s *GameService) ModerateUser(moderatorId, userId string, status domain.ModerationStatus) error {
+	if !s.isModerator(moderatorId) {
+		return domain.ErrNotModerator
+	}
+	if err := s.userRepo.SetModeration(userId, status); err != nil {
+		return err
+	}
+	fmt.Println("ModerateUser", moderatorId, "set", userId, "to", status)
+	return nil
+}
+
+// isModerator reports whether userId is in the comma separated
+// MODERATOR_USER_IDS
+func (s *GameService) isModerator(userId string) bool {
+	for _, id := range strings.Split(os.Getenv("MODERATOR_USER_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" && id == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// Leaderboard returns the board the game's score is published to, or an
+// empty name when its mode keeps scores off leaderboards
+func (s *GameService) Leaderboard(game *domain.Game) string {
+	if game.Practice {
+		return ""
+	}
+	name, err := s.LeaderboardName(game.Mode, game.StartTime)
+	if err != nil {
+		// Games from before modes were timed games
+		name, _ = s.LeaderboardName(domain.DefaultGameMode, game.StartTime)
+	}
+	return name
+}
+
+// LeaderboardName returns the board games of the named mode played at t
+// publish to
+func (s *GameService) LeaderboardName(modeName string, t time.Time) (string, error) {
+	mode, err := s.modes.Mode(modeName)
+	if err != nil {
+		return "", err
+	}
+	return mode.LeaderboardFor(t), nil
+}
+
+func (s *GameService) AddToLeaderboard(name string, user domain.User, score int32) (*domain.Table, error) {
 	fmt.Println("")
 	entry := domain.NewLeaderboardObject(user, score)
 	if entry == nil {
 		fmt.Println("GameService", "AddToLeaderboard", "entry error", entry)
 		return nil, errors.New("entry is nil")
 	}
-	table, err := s.leaderboardRepo.GetLeaderboard("qiba")
+	return s.addEntry(name, entry)
+}
+
+func (s *GameService) addEntry(name string, entry *domain.GameEntry) (*domain.Table, error) {
+	table, err := s.leaderboardRepo.GetLeaderboard(name)
 	if err != nil {
 		fmt.Println("GameService", "GetLeaderboard", "error", err)
 		return nil, err
@@ -381,12 +998,13 @@ func (s *GameService) GetUserScore(leaderboard *domain.Table, userId int64) (*do
 	return nil, errors.New("user not found")
 }
 
+// GameTime returns the length of a default timed game in seconds
 func (s *GameService) GameTime() int32 {
-	time, err := strconv.ParseInt(os.Getenv("GAME_DURATION"), 10, 32)
+	mode, err := s.modes.Mode(domain.DefaultGameMode)
 	if err != nil {
 		return 60
 	}
-	return int32(time)
+	return int32(mode.Duration / time.Second)
 }
 
 func (s *GameService) MaxPlays(user domain.User) int32 {
@@ -403,7 +1021,7 @@ func (s *GameService) MaxPlays(user domain.User) int32 {
 	count := 1
 
 	// get all games for user
-	games, err := s.repo.GetGamesByUser(userId)
+	games, err := s.allowanceGames(userId)
 
 	if err != nil {
 		fmt.Println("MaxPlays err := s.repo.GetGamesByUser(userId)", err)
@@ -434,10 +1052,10 @@ func (s *GameService) PlayCount(user domain.User) int32 {
 	}
 
 	// get all games for user
-	games, err := s.repo.GetGamesByUser(strconv.FormatInt(u.UserId, 10))
+	games, err := s.allowanceGames(strconv.FormatInt(u.UserId, 10))
 
 	if err != nil {
-		fmt.Println("PlayCount err := s.repo.GetGamesByUser(strconv.FormatInt(user.UserId, 10))", err)
+		fmt.Println("PlayCount err := s.allowanceGames(strconv.FormatInt(user.UserId, 10))", err)
 	}
 
 	count := 0
@@ -483,35 +1101,13 @@ func (s *GameService) PlaysLeft(user domain.User) int32 {
 		fmt.Println("PlaysLeft u.BonusGames > 0", u.BonusGames)
 		count += int(u.BonusGames)
 	}
-	// get all games for user
-	games, err := s.repo.GetGamesByUser(strconv.FormatInt(u.UserId, 10))
-
+	// The free play follows the same rule StartGame uses
+	free, err := s.freePlayAvailable(userId, time.Now())
 	if err != nil {
-		fmt.Println("PlaysLeft err := s.repo.GetGamesByUser(strconv.FormatInt(user.UserId, 10))", err)
+		fmt.Println("PlaysLeft err := s.freePlayAvailable(userId, time.Now())", err)
 	}
-
-	if len(games) == 0 {
+	if free {
 		count++
-	} else {
-		// check if the last game was played more than 24 hours ago
-		lastGame := games[0]
-		fmt.Println("")
-		fmt.Println("lastGame")
-		fmt.Println(lastGame)
-		fmt.Println("")
-		delay, replayErr := strconv.ParseInt(os.Getenv("REPLAY_GAME_DELAY_IN_MINUTES"), 10, 32)
-		if err != nil {
-			fmt.Println("replay game delay error", replayErr)
-		}
-		timeReference := lastGame.EndTime.UTC().Add(time.Duration(delay) * time.Minute)
-		fmt.Println("r", timeReference)
-		// use server timestamp instead of what is sent
-		time := time.Now().UTC()
-		latestGame := time.UTC().After(timeReference)
-		fmt.Println("c", time.UTC())
-		if latestGame {
-			count++
-		}
 	}
 
 	count--
@@ -1,11 +1,15 @@
 package app
 
 import (
+	"context"
+	"crypto/ed25519"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
+	"github.com/bernardbaker/qiba.core/ports"
+	"github.com/bernardbaker/qiba.core/receipt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -33,6 +37,198 @@ func (m *MockGameRepository) UpdateGame(game *domain.Game) error {
 	return args.Error(0)
 }
 
+func (m *MockGameRepository) GetGamesByUser(userID string) ([]*domain.Game, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) GetExpiredGames(now time.Time) ([]*domain.Game, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) ListGames(query domain.GameQuery) (*domain.GamePage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GamePage), args.Error(1)
+}
+
+// copyingGameRepository stores games by value like a database does, so a
+// change is only seen by later reads once the game was saved or updated
+type copyingGameRepository struct {
+	MockGameRepository
+	games   map[string]domain.Game
+	updates int
+}
+
+func newCopyingGameRepository(games ...*domain.Game) *copyingGameRepository {
+	repo := &copyingGameRepository{games: make(map[string]domain.Game)}
+	for _, game := range games {
+		repo.store(game)
+	}
+	return repo
+}
+
+func (r *copyingGameRepository) store(game *domain.Game) {
+	stored := *game
+	stored.ObjectSeq = append([]domain.GameObject(nil), game.ObjectSeq...)
+	r.games[game.ID] = stored
+}
+
+func (r *copyingGameRepository) SaveGame(game *domain.Game) error {
+	r.store(game)
+	return nil
+}
+
+func (r *copyingGameRepository) GetGame(id string) (*domain.Game, error) {
+	stored, ok := r.games[id]
+	if !ok {
+		return nil, errors.New("game not found")
+	}
+	stored.ObjectSeq = append([]domain.GameObject(nil), stored.ObjectSeq...)
+	return &stored, nil
+}
+
+func (r *copyingGameRepository) UpdateGame(game *domain.Game) error {
+	r.updates++
+	r.store(game)
+	return nil
+}
+
+// Mock Game Event Repository
+type MockGameEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockGameEventRepository) AppendEvent(event *domain.GameEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *MockGameEventRepository) GetEvents(gameID string) ([]*domain.GameEvent, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GameEvent), args.Error(1)
+}
+
+// newTestEventRepository accepts any event
+func newTestEventRepository() *MockGameEventRepository {
+	eventRepo := new(MockGameEventRepository)
+	eventRepo.On("AppendEvent", mock.AnythingOfType("*domain.GameEvent")).Return(nil).Maybe()
+	eventRepo.On("GetEvents", mock.AnythingOfType("string")).Return([]*domain.GameEvent{}, nil).Maybe()
+	return eventRepo
+}
+
+// Mock User Repository
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Save(user *domain.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Get(id string) (*domain.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Update(user *domain.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ClaimPlay(id string, now, since time.Time) (bool, error) {
+	args := m.Called(id, now, since)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) AdjustBonusGames(id string, delta int64) (bool, error) {
+	args := m.Called(id, delta)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) SetModeration(id string, status domain.ModerationStatus) error {
+	args := m.Called(id, status)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ListModeratedUsers() ([]*domain.User, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.User), args.Error(1)
+}
+
+// Mock Leaderboard Repository
+type MockLeaderboardRepository struct {
+	mock.Mock
+}
+
+func (m *MockLeaderboardRepository) SaveLeaderboard(table *domain.Table) error {
+	args := m.Called(table)
+	return args.Error(0)
+}
+
+func (m *MockLeaderboardRepository) GetLeaderboard(name string) (*domain.Table, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Table), args.Error(1)
+}
+
+func (m *MockLeaderboardRepository) AddEntryToLeaderboard(table *domain.Table, entry *domain.GameEntry) error {
+	args := m.Called(table, entry)
+	return args.Error(0)
+}
+
+func (m *MockLeaderboardRepository) UpdateLeaderboard(table *domain.Table) error {
+	args := m.Called(table)
+	return args.Error(0)
+}
+
+// Mock Review Repository
+type MockReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewRepository) SaveReview(review *domain.GameReview) error {
+	args := m.Called(review)
+	return args.Error(0)
+}
+
+func (m *MockReviewRepository) GetReview(gameID string) (*domain.GameReview, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GameReview), args.Error(1)
+}
+
+func (m *MockReviewRepository) ListReviews(status domain.ReviewStatus, limit int) ([]*domain.GameReview, error) {
+	args := m.Called(status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GameReview), args.Error(1)
+}
+
 // Mock Encrypter
 type MockEncrypter struct {
 	mock.Mock
@@ -43,14 +239,74 @@ func (m *MockEncrypter) EncryptGameData(objects interface{}) (string, string, er
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockEncrypter) VerifyGameData(encryptedData, hmac string) error {
+	args := m.Called(encryptedData, hmac)
+	return args.Error(0)
+}
+
+func (m *MockEncrypter) DecryptGameData(encryptedData, hmac string, v interface{}) error {
+	args := m.Called(encryptedData, hmac, v)
+	return args.Error(0)
+}
+
+// newTestEncrypter encrypts any game ticket
+func newTestEncrypter() *MockEncrypter {
+	encrypter := new(MockEncrypter)
+	encrypter.On("EncryptGameData", mock.AnythingOfType("domain.GameTicket")).Return("encrypted_data", "hmac_value", nil).Maybe()
+	return encrypter
+}
+
+// newTestModes registers the built in game modes with one minute timed games
+func newTestModes() *domain.ModeRegistry {
+	modes, _ := domain.NewModeRegistry(domain.DefaultGameModes(time.Minute), domain.DefaultRuleBook())
+	return modes
+}
+
+// allowFreePlay lets user "1" start games on their free play
+func allowFreePlay(repo *MockGameRepository, userRepo *MockUserRepository) {
+	userRepo.On("Get", "1").Return(&domain.User{UserId: 1}, nil)
+	repo.On("GetGamesByUser", "1").Return([]*domain.Game{}, nil)
+	userRepo.On("ClaimPlay", "1", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(true, nil)
+}
+
+// newTestLeaderboardRepository accepts entries on any board
+func newTestLeaderboardRepository() *MockLeaderboardRepository {
+	leaderboardRepo := new(MockLeaderboardRepository)
+	leaderboardRepo.On("GetLeaderboard", mock.AnythingOfType("string")).Return(domain.NewLeaderboard("qiba"), nil).Maybe()
+	leaderboardRepo.On("AddEntryToLeaderboard", mock.Anything, mock.AnythingOfType("*domain.GameEntry")).Return(nil).Maybe()
+	return leaderboardRepo
+}
+
+// newTestUserRepository knows the user with ID "1"
+func newTestUserRepository() *MockUserRepository {
+	userRepo := new(MockUserRepository)
+	userRepo.On("Get", "1").Return(&domain.User{UserId: 1, Username: "player"}, nil).Maybe()
+	return userRepo
+}
+
+// newTestSigner signs receipts with a fixed key
+func newTestSigner() *receipt.Signer {
+	return receipt.NewSigner("test", ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+}
+
+// testReplayDelay is the wait for the next free play in test services
+const testReplayDelay = time.Hour
+
+func newTestGameService(repo *MockGameRepository, encrypter *MockEncrypter) (*GameService, *MockUserRepository) {
+	userRepo := new(MockUserRepository)
+	leaderboardRepo := new(MockLeaderboardRepository)
+	return NewGameService(repo, newTestEventRepository(), userRepo, leaderboardRepo, new(MockReviewRepository), encrypter, newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay), userRepo
+}
+
 func TestNewGameService(t *testing.T) {
 	repo := new(MockGameRepository)
 	encrypter := new(MockEncrypter)
 
-	service := NewGameService(repo, encrypter)
+	service, userRepo := newTestGameService(repo, encrypter)
 
 	assert.NotNil(t, service)
 	assert.Equal(t, repo, service.repo)
+	assert.Equal(t, userRepo, service.userRepo)
 	assert.Equal(t, encrypter, service.encrypter)
 }
 
@@ -58,13 +314,14 @@ func TestStartGame(t *testing.T) {
 	t.Run("successful game start", func(t *testing.T) {
 		repo := new(MockGameRepository)
 		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, encrypter)
+		allowFreePlay(repo, userRepo)
 
 		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
-		encrypter.On("EncryptGameData", mock.AnythingOfType("[]domain.GameObject")).
+		encrypter.On("EncryptGameData", mock.AnythingOfType("domain.GameTicket")).
 			Return("encrypted_data", "hmac_value", nil)
 
-		encryptedData, hmac, _, err := service.StartGame()
+		encryptedData, hmac, _, err := service.StartGame("1", domain.User{UserId: 1}, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, "encrypted_data", encryptedData)
@@ -75,177 +332,1470 @@ func TestStartGame(t *testing.T) {
 
 	t.Run("save game fails", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		encrypter := newTestEncrypter()
+		service, userRepo := newTestGameService(repo, encrypter)
+		allowFreePlay(repo, userRepo)
 
 		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).
 			Return(assert.AnError)
 
-		encryptedData, hmac, gameId, err := service.StartGame()
+		encryptedData, hmac, game, err := service.StartGame("1", domain.User{UserId: 1}, "")
 
 		assert.Error(t, err)
 		assert.Empty(t, encryptedData)
 		assert.Empty(t, hmac)
-		assert.Empty(t, gameId)
+		assert.Nil(t, game)
 		repo.AssertExpectations(t)
 	})
 
 	t.Run("encryption fails", func(t *testing.T) {
 		repo := new(MockGameRepository)
 		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, encrypter)
+		allowFreePlay(repo, userRepo)
 
-		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
-		encrypter.On("EncryptGameData", mock.AnythingOfType("[]domain.GameObject")).
+		encrypter.On("EncryptGameData", mock.AnythingOfType("domain.GameTicket")).
 			Return("", "", assert.AnError)
 
-		encryptedData, hmac, _, err := service.StartGame()
+		encryptedData, hmac, _, err := service.StartGame("1", domain.User{UserId: 1}, "")
 
 		assert.Error(t, err)
 		assert.Empty(t, encryptedData)
 		assert.Empty(t, hmac)
-		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "SaveGame", mock.Anything)
+		userRepo.AssertNotCalled(t, "ClaimPlay", mock.Anything, mock.Anything, mock.Anything)
 		encrypter.AssertExpectations(t)
 	})
-}
 
-func TestTap(t *testing.T) {
-	t.Run("successful tap on type 'a'", func(t *testing.T) {
+	t.Run("banned user", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1, Moderation: domain.ModerationBanned}, nil)
 
-		game := &domain.Game{
-			ObjectSeq: []domain.GameObject{
-				{
-					ID:        "obj1",
-					Type:      "a",
-					Timestamp: time.Now().Add(-time.Minute),
-				},
-			},
-			Score: 0,
-		}
+		_, _, game, err := service.StartGame("1", domain.User{UserId: 1}, "")
 
-		repo.On("GetGame", "game1").Return(game, nil)
-		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+		assert.ErrorIs(t, err, domain.ErrUserBanned)
+		assert.Nil(t, game)
+		repo.AssertNotCalled(t, "SaveGame", mock.Anything)
+		userRepo.AssertNotCalled(t, "ClaimPlay", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
 
-		success, err := service.Tap("game1", "obj1", time.Now())
+func TestModeration(t *testing.T) {
+	t.Setenv("MODERATOR_USER_IDS", "7, 8")
+
+	t.Run("moderator sets status", func(t *testing.T) {
+		service, userRepo := newTestGameService(new(MockGameRepository), newTestEncrypter())
+		userRepo.On("SetModeration", "1", domain.ModerationQuarantined).Return(nil).Once()
+
+		err := service.ModerateUser("8", "1", domain.ModerationQuarantined)
 
 		assert.NoError(t, err)
-		assert.True(t, success)
-		assert.Equal(t, int32(1), game.Score)
-		repo.AssertExpectations(t)
+		userRepo.AssertExpectations(t)
 	})
 
-	t.Run("successful tap on type 'b'", func(t *testing.T) {
-		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+	t.Run("other users can't moderate", func(t *testing.T) {
+		service, userRepo := newTestGameService(new(MockGameRepository), newTestEncrypter())
 
-		game := &domain.Game{
-			ObjectSeq: []domain.GameObject{
-				{
-					ID:        "obj1",
-					Type:      "b",
-					Timestamp: time.Now().Add(-time.Minute),
-				},
-			},
-			Score: 0,
+		err := service.ModerateUser("1", "2", domain.ModerationBanned)
+
+		assert.ErrorIs(t, err, domain.ErrNotModerator)
+		userRepo.AssertNotCalled(t, "SetModeration", mock.Anything, mock.Anything)
+	})
+
+	t.Run("quarantined scores are only shown to their owner", func(t *testing.T) {
+		leaderboardRepo := new(MockLeaderboardRepository)
+		userRepo := new(MockUserRepository)
+		service := NewGameService(new(MockGameRepository), newTestEventRepository(), userRepo, leaderboardRepo, new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+		cheater := domain.User{UserId: 1, Username: "cheater"}
+		player := domain.User{UserId: 2, Username: "player"}
+		board := domain.NewLeaderboard("qiba")
+		board.Entries = []domain.GameEntry{{User: cheater, Score: 90}, {User: player, Score: 10}}
+		leaderboardRepo.On("GetLeaderboard", "qiba").Return(board, nil)
+		userRepo.On("ListModeratedUsers").Return([]*domain.User{{UserId: 1, Moderation: domain.ModerationQuarantined}}, nil)
+
+		others, _, err := service.GetLeaderboard("qiba", &player, &player)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"Username":"player","Score":10}]`, others)
+
+		own, _, err := service.GetLeaderboard("qiba", &cheater, &cheater)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"Username":"cheater","Score":90},{"Username":"player","Score":10}]`, own)
+
+		// Claiming to be the quarantined user without a verified identity
+		// shows nothing of theirs
+		claimed, userScore, err := service.GetLeaderboard("qiba", &cheater, nil)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"Username":"player","Score":10}]`, claimed)
+		assert.JSONEq(t, `[]`, userScore)
+	})
+}
+
+func TestCheckGameData(t *testing.T) {
+	ticketFor := func(gameID string) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			*args.Get(2).(*domain.GameTicket) = domain.GameTicket{GameID: gameID}
 		}
+	}
 
-		repo.On("GetGame", "game1").Return(game, nil)
-		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+	t.Run("data issued for the game", func(t *testing.T) {
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(new(MockGameRepository), encrypter)
+		encrypter.On("DecryptGameData", "data", "hmac", mock.AnythingOfType("*domain.GameTicket")).Run(ticketFor("game1")).Return(nil)
+
+		assert.NoError(t, service.CheckGameData("game1", "data", "hmac"))
+	})
+
+	t.Run("data issued for another game", func(t *testing.T) {
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(new(MockGameRepository), encrypter)
+		encrypter.On("DecryptGameData", "data", "hmac", mock.AnythingOfType("*domain.GameTicket")).Run(ticketFor("game2")).Return(nil)
+
+		assert.ErrorIs(t, service.CheckGameData("game1", "data", "hmac"), ports.ErrGameDataTampered)
+	})
+
+	t.Run("tampered data", func(t *testing.T) {
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(new(MockGameRepository), encrypter)
+		encrypter.On("DecryptGameData", "data", "forged", mock.AnythingOfType("*domain.GameTicket")).Return(ports.ErrGameDataTampered)
+
+		assert.ErrorIs(t, service.CheckGameData("game1", "data", "forged"), ports.ErrGameDataTampered)
+	})
+}
+
+func TestPlayAllowance(t *testing.T) {
+	user := domain.User{UserId: 1}
+	recent := &domain.Game{ID: "recent", UserID: "1", Mode: domain.GameModeTimed, EndTime: time.Now().Add(-time.Minute)}
+	anyTime := mock.AnythingOfType("time.Time")
+
+	t.Run("free play is used first", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		allowFreePlay(repo, userRepo)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
 
-		success, err := service.Tap("game1", "obj1", time.Now())
+		_, _, game, err := service.StartGame("1", user, "")
 
 		assert.NoError(t, err)
-		assert.True(t, success)
-		assert.Equal(t, int32(-5), game.Score)
-		repo.AssertExpectations(t)
+		assert.NotNil(t, game)
+		userRepo.AssertNotCalled(t, "AdjustBonusGames", mock.Anything, mock.Anything)
 	})
 
-	t.Run("game not found", func(t *testing.T) {
+	t.Run("bonus game is used once the free play is gone", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1, BonusGames: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return([]*domain.Game{recent}, nil)
+		userRepo.On("AdjustBonusGames", "1", int64(-1)).Return(true, nil).Once()
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
 
-		repo.On("GetGame", "game1").Return(nil, assert.AnError)
+		_, _, game, err := service.StartGame("1", user, "")
 
-		success, err := service.Tap("game1", "obj1", time.Now())
+		assert.NoError(t, err)
+		assert.NotNil(t, game)
+		userRepo.AssertNotCalled(t, "ClaimPlay", mock.Anything, mock.Anything, mock.Anything)
+		userRepo.AssertExpectations(t)
+	})
 
-		assert.Error(t, err)
-		assert.False(t, success)
-		repo.AssertExpectations(t)
+	t.Run("a lost free play claim falls back to a bonus game", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return([]*domain.Game{}, nil)
+		userRepo.On("ClaimPlay", "1", anyTime, anyTime).Return(false, nil)
+		userRepo.On("AdjustBonusGames", "1", int64(-1)).Return(false, nil)
+
+		_, _, game, err := service.StartGame("1", user, "")
+
+		assert.ErrorIs(t, err, domain.ErrNoPlaysLeft)
+		assert.Nil(t, game)
+		repo.AssertNotCalled(t, "SaveGame", mock.Anything)
 	})
 
-	t.Run("object not found", func(t *testing.T) {
+	t.Run("no plays left", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return([]*domain.Game{recent}, nil)
+		userRepo.On("AdjustBonusGames", "1", int64(-1)).Return(false, nil)
 
-		game := &domain.Game{
-			ObjectSeq: []domain.GameObject{},
-			Score:     0,
-		}
+		_, _, game, err := service.StartGame("1", user, "")
 
-		repo.On("GetGame", "game1").Return(game, nil)
+		assert.ErrorIs(t, err, domain.ErrNoPlaysLeft)
+		assert.Nil(t, game)
+		repo.AssertNotCalled(t, "SaveGame", mock.Anything)
+		assert.False(t, service.CanPlay(user))
+	})
+
+	t.Run("practice games need no plays", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1}, nil)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
 
-		success, err := service.Tap("game1", "nonexistent", time.Now())
+		_, _, game, err := service.StartGame("1", user, domain.GameModePractice)
 
 		assert.NoError(t, err)
-		assert.False(t, success)
-		assert.Equal(t, int32(0), game.Score)
-		repo.AssertExpectations(t)
+		assert.True(t, game.Practice)
+		userRepo.AssertNotCalled(t, "ClaimPlay", mock.Anything, mock.Anything, mock.Anything)
+		userRepo.AssertNotCalled(t, "AdjustBonusGames", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed start hands the bonus game back", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1, BonusGames: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return([]*domain.Game{recent}, nil)
+		userRepo.On("AdjustBonusGames", "1", int64(-1)).Return(true, nil).Once()
+		userRepo.On("AdjustBonusGames", "1", int64(1)).Return(true, nil).Once()
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(assert.AnError)
+
+		_, _, _, err := service.StartGame("1", user, "")
+
+		assert.Error(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("can play does not use plays", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1, BonusGames: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return([]*domain.Game{recent}, nil)
+
+		assert.True(t, service.CanPlay(user))
+		assert.True(t, service.CanPlay(user))
+		userRepo.AssertNotCalled(t, "AdjustBonusGames", mock.Anything, mock.Anything)
+		userRepo.AssertNotCalled(t, "Save", mock.Anything)
 	})
 }
 
-func TestEndGame(t *testing.T) {
-	t.Run("successful game end", func(t *testing.T) {
-		t.Skip("Skipping this specific test case")
+func TestGameModes(t *testing.T) {
+	t.Run("games record their mode", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+		allowFreePlay(repo, userRepo)
 
-		game := &domain.Game{Score: 10}
-		repo.On("GetGame", "game1").Return(game, nil)
-		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+		_, _, timed, err := service.StartGame("1", domain.User{UserId: 1}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GameModeTimed, timed.Mode)
+		assert.Equal(t, "classic-1", timed.RulesVersion)
+		assert.Equal(t, time.Minute, timed.ExpiresAt.Sub(timed.StartTime))
+		assert.Equal(t, "qiba", service.Leaderboard(timed))
 
-		score, err := service.EndGame("game1")
+		_, _, endless, err := service.StartGame("1", domain.User{UserId: 1}, domain.GameModeEndless)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GameModeEndless, endless.Mode)
+		assert.Equal(t, "endless-1", endless.RulesVersion)
+		assert.Equal(t, int32(3), endless.MaxMistakes)
+		assert.Equal(t, domain.EndlessGameDuration, endless.ExpiresAt.Sub(endless.StartTime))
+		assert.Equal(t, "qiba-endless", service.Leaderboard(endless))
 
+		_, _, practice, err := service.StartGame("1", domain.User{UserId: 1}, domain.GameModePractice)
 		assert.NoError(t, err)
-		assert.Equal(t, int32(10), score)
-		assert.NotZero(t, game.EndTime)
-		repo.AssertExpectations(t)
+		assert.Equal(t, domain.GameModePractice, practice.Mode)
+		assert.Empty(t, service.Leaderboard(practice))
 	})
 
-	t.Run("game not found", func(t *testing.T) {
-		t.Skip("Skipping this specific test case")
+	t.Run("unknown mode", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
-
-		repo.On("GetGame", "game1").Return(nil, assert.AnError)
+		service, _ := newTestGameService(repo, newTestEncrypter())
 
-		score, err := service.EndGame("game1")
+		_, _, game, err := service.StartGame("1", domain.User{UserId: 1}, "marathon")
 
-		assert.Error(t, err)
-		assert.Equal(t, int32(0), score)
-		repo.AssertExpectations(t)
+		assert.ErrorIs(t, err, domain.ErrUnknownGameMode)
+		assert.Nil(t, game)
+		repo.AssertNotCalled(t, "SaveGame", mock.Anything)
 	})
 
-	t.Run("update game fails", func(t *testing.T) {
+	t.Run("daily challenge", func(t *testing.T) {
 		repo := new(MockGameRepository)
-		encrypter := new(MockEncrypter)
-		service := NewGameService(repo, encrypter)
+		userRepo := new(MockUserRepository)
+		leaderboardRepo := new(MockLeaderboardRepository)
+		service := NewGameService(repo, newTestEventRepository(), userRepo, leaderboardRepo, new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
 
-		game := &domain.Game{Score: 10}
-		repo.On("GetGame", "game1").Return(game, nil)
-		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(errors.New("update failed"))
+		board := "daily-" + time.Now().UTC().Format(time.DateOnly)
+		leaderboardRepo.On("GetLeaderboard", board).Return(domain.NewLeaderboard(board), nil)
+		userRepo.On("Get", mock.AnythingOfType("string")).Return(&domain.User{}, nil)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+		played := &domain.Game{ID: "earlier", UserID: "1", Mode: domain.GameModeDaily}
+		repo.On("ListGames", mock.MatchedBy(func(q domain.GameQuery) bool { return q.UserID == "1" })).
+			Return(&domain.GamePage{Games: []*domain.Game{played}}, nil)
+		repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+
+		_, _, first, err := service.StartGame("2", domain.User{UserId: 2}, domain.GameModeDaily)
+		assert.NoError(t, err)
+		_, _, second, err := service.StartGame("3", domain.User{UserId: 3}, domain.GameModeDaily)
+		assert.NoError(t, err)
+		assert.Equal(t, first.Seed, second.Seed)
+		assert.Equal(t, board, service.Leaderboard(first))
 
-		score, err := service.EndGame("game1")
+		firstObjects, _ := domain.GenerateSequence(first.GeneratorVersion, first.Seed, 10, first.StartTime, &domain.DefaultRules)
+		secondObjects, _ := domain.GenerateSequence(second.GeneratorVersion, second.Seed, 10, first.StartTime, &domain.DefaultRules)
+		assert.Equal(t, firstObjects, secondObjects)
 
-		assert.Error(t, err)
-		assert.Equal(t, int32(0), score)
-		repo.AssertExpectations(t)
+		yesterday := domain.DailySeed("", time.Now().AddDate(0, 0, -1))
+		assert.NotEqual(t, yesterday, domain.DailySeed("", time.Now()))
+
+		_, _, again, err := service.StartGame("1", domain.User{UserId: 1}, domain.GameModeDaily)
+		assert.ErrorIs(t, err, domain.ErrDailyChallengePlayed)
+		assert.Nil(t, again)
+
+		query := repo.Calls[0].Arguments.Get(0).(domain.GameQuery)
+		assert.Equal(t, domain.GameModeDaily, query.Mode)
+		assert.Equal(t, 24*time.Hour, query.To.Sub(query.From))
+	})
+
+	t.Run("misses are stored", func(t *testing.T) {
+		mode, err := newTestModes().Mode(domain.GameModeEndless)
+		assert.NoError(t, err)
+		game := domain.NewGame("1", mode, &domain.DefaultEndlessRules)
+		game.ID = "game1"
+		spawned := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+		for _, id := range []string{"gone1", "gone2", "gone3", "gone4"} {
+			game.ObjectSeq = append(game.ObjectSeq, domain.GameObject{ID: id, Type: "a", Points: 1, Timestamp: spawned, Lifetime: time.Second})
+		}
+		repo := newCopyingGameRepository(game)
+		service := NewGameService(repo, newTestEventRepository(), newTestUserRepository(), newTestLeaderboardRepository(), new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+		for _, id := range []string{"gone1", "gone2", "gone3"} {
+			outcome, err := service.Tap("game1", id, time.Now())
+			assert.NoError(t, err)
+			assert.Equal(t, domain.TapMiss, outcome.Result)
+		}
+		_, err = service.Tap("game1", "gone4", time.Now())
+		assert.ErrorIs(t, err, domain.ErrNoMistakesLeft)
+
+		stored, err := repo.GetGame("game1")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), stored.Mistakes)
+		assert.Equal(t, 4, repo.updates)
+	})
+
+	t.Run("endless games stop after three mistakes", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+
+		mode, err := newTestModes().Mode(domain.GameModeEndless)
+		assert.NoError(t, err)
+		game := domain.NewGame("1", mode, &domain.DefaultEndlessRules)
+		spawned := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+		game.ObjectSeq = []domain.GameObject{
+			{ID: "bomb1", Type: "b", Points: -5, Timestamp: spawned},
+			{ID: "bomb2", Type: "b", Points: -5, Timestamp: spawned},
+			{ID: "gone", Type: "a", Points: 1, Timestamp: spawned.Add(-time.Minute), Lifetime: time.Second},
+			{ID: "obj1", Type: "a", Points: 1, Timestamp: spawned},
+		}
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", game).Return(nil)
+
+		for _, id := range []string{"bomb1", "bomb2", "gone"} {
+			_, err := service.Tap("game1", id, time.Now())
+			assert.NoError(t, err)
+		}
+		_, err = service.Tap("game1", "obj1", time.Now())
+		assert.ErrorIs(t, err, domain.ErrNoMistakesLeft)
+		assert.Equal(t, int32(3), game.Mistakes)
+		assert.Equal(t, int32(-10), game.Score)
+
+		ended, err := service.EndGame("game1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GameStatusEnded, ended.Status)
+	})
+}
+
+func TestPracticeGames(t *testing.T) {
+	user := domain.User{UserId: 1}
+	now := time.Now()
+	practice := &domain.Game{ID: "practice", UserID: "1", Mode: domain.GameModePractice, Practice: true, StartTime: now, EndTime: now}
+	timed := &domain.Game{ID: "timed", UserID: "1", Mode: domain.GameModeTimed, StartTime: now, EndTime: now}
+
+	newService := func(games ...*domain.Game) *GameService {
+		repo := new(MockGameRepository)
+		service, userRepo := newTestGameService(repo, newTestEncrypter())
+		userRepo.On("Get", "1").Return(&domain.User{UserId: 1}, nil)
+		repo.On("GetGamesByUser", "1").Return(games, nil)
+		return service
+	}
+
+	t.Run("practice games are flagged and never published", func(t *testing.T) {
+		mode, err := newTestModes().Mode(domain.GameModePractice)
+		assert.NoError(t, err)
+		game := domain.NewGame("1", mode, &domain.DefaultRules)
+
+		assert.True(t, game.Practice)
+		assert.Empty(t, newService().Leaderboard(game))
+	})
+
+	t.Run("practice games do not use plays", func(t *testing.T) {
+		t.Setenv("PLAY_TIME_WINDOW", "2")
+
+		assert.Equal(t, int32(0), newService(practice).PlayCount(user))
+		assert.Equal(t, int32(1), newService(timed, practice).PlayCount(user))
+		assert.Equal(t, newService().PlaysLeft(user), newService(practice).PlaysLeft(user))
+		assert.True(t, newService(practice).CanPlay(user))
+		assert.False(t, newService(timed).CanPlay(user))
+	})
+
+	t.Run("practice modes cannot cost plays", func(t *testing.T) {
+		modes := []domain.GameMode{{Name: "drill", Duration: time.Minute, Rules: domain.DefaultGameMode, AllowanceCost: 1, Practice: true}}
+
+		_, err := domain.NewModeRegistry(modes, domain.DefaultRuleBook())
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGameMode)
+	})
+}
+
+func TestTap(t *testing.T) {
+	t.Run("successful tap on type 'a'", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "a",
+					Points:    1,
+					Timestamp: time.Now().Add(-time.Minute),
+				},
+			},
+			Score:     0,
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapAccepted, result.Result)
+		assert.Equal(t, int32(1), game.Score)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("successful tap on type 'b'", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "b",
+					Points:    -5,
+					Timestamp: time.Now().Add(-time.Minute),
+				},
+			},
+			Score:     0,
+			Streak:    3,
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapAccepted, result.Result)
+		assert.Equal(t, int32(-5), result.Score)
+		assert.Equal(t, int32(0), result.Streak)
+		assert.Equal(t, int32(-5), game.Score)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("game not found", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		repo.On("GetGame", "game1").Return(nil, assert.AnError)
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.Error(t, err)
+		assert.Empty(t, result.Result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{},
+			Score:     0,
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		result, err := service.Tap("game1", "nonexistent", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapUnknownObject, result.Result)
+		assert.Equal(t, int32(0), game.Score)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate tap scores once", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "a",
+					Points:    1,
+					Timestamp: time.Now().Add(-time.Second),
+				},
+			},
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil).Once()
+
+		first, err := service.Tap("game1", "obj1", time.Now())
+		assert.NoError(t, err)
+		second, err := service.Tap("game1", "obj1", time.Now())
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.TapAccepted, first.Result)
+		assert.Equal(t, domain.TapDuplicate, second.Result)
+		assert.Equal(t, int32(1), second.Score)
+		assert.Equal(t, int32(1), second.Streak)
+		assert.Equal(t, int32(1), game.Score)
+		assert.True(t, game.ObjectSeq[0].Tapped)
+		assert.False(t, game.ObjectSeq[0].TappedAt.IsZero())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("tap before the object spawned", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "a",
+					Points:    1,
+					Timestamp: time.Now().Add(time.Second),
+				},
+			},
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", game).Return(nil).Once()
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapMiss, result.Result)
+		assert.Equal(t, int32(0), game.Score)
+		assert.Equal(t, int32(1), game.Mistakes)
+		assert.False(t, game.ObjectSeq[0].Tapped)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("tap after the object disappeared", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "a",
+					Points:    1,
+					Timestamp: time.Now().Add(-2 * time.Second),
+					Lifetime:  time.Second,
+				},
+			},
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", game).Return(nil).Once()
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapMiss, result.Result)
+		assert.Equal(t, int32(0), game.Score)
+		assert.Equal(t, int32(1), game.Mistakes)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("tap faster than a person can react", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		spawned := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		game.ObjectSeq = []domain.GameObject{{ID: "obj1", Type: "a", Points: 1, Timestamp: spawned}}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil).Once()
+
+		tooFast, err := service.Tap("game1", "obj1", spawned.Add(30*time.Millisecond))
+		assert.NoError(t, err)
+		human, err := service.Tap("game1", "obj1", spawned.Add(350*time.Millisecond))
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.TapTooFast, tooFast.Result)
+		assert.Equal(t, 30*time.Millisecond, tooFast.ReactionTime)
+		assert.Equal(t, domain.TapAccepted, human.Result)
+		assert.Equal(t, 350*time.Millisecond, game.ObjectSeq[0].ReactionTime)
+		assert.Equal(t, int32(1), game.Score)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("tap timestamp outside the visible window", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		spawned := time.Now().Add(-500 * time.Millisecond)
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		game.ObjectSeq = []domain.GameObject{{ID: "obj1", Type: "a", Points: 1, Timestamp: spawned, Lifetime: time.Second}}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		result, err := service.Tap("game1", "obj1", spawned.Add(-time.Second))
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TapMiss, result.Result)
+		assert.Equal(t, int32(0), game.Score)
+	})
+
+	t.Run("tap after the game duration", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{
+			ObjectSeq: []domain.GameObject{
+				{
+					ID:        "obj1",
+					Type:      "a",
+					Points:    1,
+					Timestamp: time.Now().Add(-2 * time.Minute),
+				},
+			},
+			Status:    domain.GameStatusRunning,
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}
+
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		result, err := service.Tap("game1", "obj1", time.Now())
+
+		assert.ErrorIs(t, err, domain.ErrGameExpired)
+		assert.Empty(t, result.Result)
+		assert.Equal(t, int32(0), game.Score)
+		assert.Equal(t, domain.GameStatusExpired, game.Status)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestEndGame(t *testing.T) {
+	t.Run("successful game end", func(t *testing.T) {
+		t.Skip("Skipping this specific test case")
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{Score: 10}
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(10), ended.Score)
+		assert.NotZero(t, game.EndTime)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("game not found", func(t *testing.T) {
+		t.Skip("Skipping this specific test case")
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		repo.On("GetGame", "game1").Return(nil, assert.AnError)
+
+		ended, err := service.EndGame("game1")
+
+		assert.Error(t, err)
+		assert.Nil(t, ended)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("update game fails", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := &domain.Game{Score: 10}
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", mock.AnythingOfType("*domain.Game")).Return(errors.New("update failed"))
+
+		ended, err := service.EndGame("game1")
+
+		assert.Error(t, err)
+		assert.Nil(t, ended)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("game already finalized", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		result := &domain.GameResult{Score: 10, Verified: true, Receipt: "receipt", Leaderboard: "qiba"}
+		game := &domain.Game{Score: 10, Status: domain.GameStatusEnded, Result: result}
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.Same(t, result, ended.Result)
+		repo.AssertNotCalled(t, "UpdateGame", mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("storing the result fails", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		// Ended by an earlier call, its unverified score is not posted
+		game := &domain.Game{Score: 10, Status: domain.GameStatusEnded}
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", game).Return(errors.New("update failed"))
+
+		ended, err := service.EndGame("game1")
+
+		assert.Error(t, err)
+		assert.Nil(t, ended)
+		assert.False(t, game.IsFinalized())
+	})
+}
+
+func TestGameEvents(t *testing.T) {
+	repo := new(MockGameRepository)
+	eventRepo := new(MockGameEventRepository)
+	service := NewGameService(repo, eventRepo, newTestUserRepository(), newTestLeaderboardRepository(), new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+	game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+	game.StartTime = time.Now().Add(-10 * time.Second)
+	repo.On("GetGame", "game1").Return(game, nil)
+	repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+	repo.On("SaveGame", game).Return(nil)
+	repo.On("UpdateGame", game).Return(nil)
+
+	var events []*domain.GameEvent
+	eventRepo.On("AppendEvent", mock.AnythingOfType("*domain.GameEvent")).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(0).(*domain.GameEvent))
+	}).Return(nil)
+	getEvents := eventRepo.On("GetEvents", mock.AnythingOfType("string"))
+	getEvents.Run(func(mock.Arguments) {
+		getEvents.ReturnArguments = mock.Arguments{events, nil}
+	})
+
+	obj, err := service.Spawn("game1")
+	assert.NoError(t, err)
+	_, err = service.Tap("game1", obj.ID, time.Now())
+	assert.NoError(t, err)
+	_, err = service.Tap("game1", obj.ID, time.Now())
+	assert.NoError(t, err)
+	_, err = service.Tap("game1", "nonexistent", time.Now())
+	assert.NoError(t, err)
+	ended, err := service.EndGame("game1")
+	assert.NoError(t, err)
+	assert.True(t, ended.Verification.Verified())
+	_, err = service.Tap("game1", obj.ID, time.Now())
+	assert.ErrorIs(t, err, domain.ErrGameEnded)
+
+	assert.Len(t, events, 6)
+	assert.Equal(t, domain.GameEventSpawn, events[0].Type)
+	assert.Equal(t, obj.ID, events[0].ObjectID)
+	assert.Equal(t, obj.Points, events[0].Points)
+	assert.Equal(t, domain.TapAccepted, events[1].TapResult)
+	assert.Equal(t, obj.Points, events[1].Score)
+	assert.Equal(t, domain.TapDuplicate, events[2].TapResult)
+	assert.Equal(t, domain.TapUnknownObject, events[3].TapResult)
+	assert.Equal(t, domain.GameEventEnd, events[4].Type)
+	assert.Equal(t, domain.GameEventTap, events[5].Type)
+	assert.Empty(t, events[5].TapResult)
+	assert.Equal(t, domain.ErrGameEnded.Error(), events[5].Reason)
+
+	replay, err := service.GetGameEvents("game1")
+	assert.NoError(t, err)
+	assert.Equal(t, events, replay)
+}
+
+func TestEndGameOnce(t *testing.T) {
+	repo := new(MockGameRepository)
+	leaderboardRepo := new(MockLeaderboardRepository)
+	service := NewGameService(repo, newTestEventRepository(), newTestUserRepository(), leaderboardRepo, new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+	// A game without taps replays to its score of zero
+	game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+	repo.On("GetGame", "game1").Return(game, nil)
+	repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+	repo.On("UpdateGame", game).Return(nil)
+	board := domain.NewLeaderboard("qiba")
+	leaderboardRepo.On("GetLeaderboard", "qiba").Return(board, nil)
+	leaderboardRepo.On("AddEntryToLeaderboard", board, mock.MatchedBy(func(entry *domain.GameEntry) bool {
+		return entry.GameID == game.ID && entry.User.Username == "player"
+	})).Return(nil).Once()
+
+	first, err := service.EndGame("game1")
+	assert.NoError(t, err)
+	assert.True(t, first.Result.Verified)
+	assert.Equal(t, "qiba", first.Result.Leaderboard)
+	assert.NotEmpty(t, first.Result.Receipt)
+	result := *first.Result
+
+	retry, err := service.EndGame("game1")
+	assert.NoError(t, err)
+	assert.Equal(t, result, *retry.Result)
+	leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 1)
+}
+
+func TestEndGameRiskReview(t *testing.T) {
+	repo := new(MockGameRepository)
+	eventRepo := new(MockGameEventRepository)
+	leaderboardRepo := new(MockLeaderboardRepository)
+	reviewRepo := new(MockReviewRepository)
+	service := NewGameService(repo, eventRepo, newTestUserRepository(), leaderboardRepo, reviewRepo, newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+	game := domain.NewGameWithSeed("1", 7, &domain.DefaultRules, time.Minute)
+	game.StartTime = time.Now().Add(-30 * time.Second)
+	repo.On("GetGame", "game1").Return(game, nil)
+	repo.On("SaveGame", game).Return(nil)
+	repo.On("UpdateGame", game).Return(nil)
+	repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+	var events []*domain.GameEvent
+	eventRepo.On("AppendEvent", mock.AnythingOfType("*domain.GameEvent")).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(0).(*domain.GameEvent))
+	}).Return(nil)
+	getEvents := eventRepo.On("GetEvents", game.ID)
+	getEvents.Run(func(mock.Arguments) {
+		getEvents.ReturnArguments = mock.Arguments{events, nil}
+	})
+	reviewRepo.On("SaveReview", mock.MatchedBy(func(review *domain.GameReview) bool {
+		return review.GameID == game.ID && review.Leaderboard == "qiba" && review.Status == domain.ReviewPending
+	})).Return(nil).Once()
+
+	// A bot taps every object exactly 200ms after it appears
+	for i := 0; i < 8; i++ {
+		obj, err := service.Spawn("game1")
+		assert.NoError(t, err)
+		_, err = service.Tap("game1", obj.ID, obj.Timestamp.Add(200*time.Millisecond))
+		assert.NoError(t, err)
+	}
+
+	ended, err := service.EndGame("game1")
+
+	assert.NoError(t, err)
+	assert.True(t, ended.Result.Verified)
+	assert.True(t, ended.Result.UnderReview)
+	assert.Empty(t, ended.Result.Receipt)
+	assert.Empty(t, ended.Result.Leaderboard)
+	assert.True(t, ended.Risk.Exceeds(domain.DefaultRiskThreshold))
+	reviewRepo.AssertExpectations(t)
+	leaderboardRepo.AssertNotCalled(t, "AddEntryToLeaderboard", mock.Anything, mock.Anything)
+}
+
+func TestReviewDecisions(t *testing.T) {
+	t.Setenv("MODERATOR_USER_IDS", "7")
+	// held returns a service with an ended game whose score is held for review
+	held := func(t *testing.T) (*GameService, *copyingGameRepository, *MockLeaderboardRepository, *MockReviewRepository, *domain.GameReview) {
+		game := domain.NewGameWithSeed("1", 7, &domain.DefaultRules, time.Minute)
+		game.Score = 40
+		game.Status = domain.GameStatusEnded
+		game.Verification = domain.ScoreVerification{Status: domain.VerificationVerified}
+		game.Result = &domain.GameResult{Score: 40, Verified: true, UnderReview: true}
+		repo := newCopyingGameRepository(game)
+		leaderboardRepo := newTestLeaderboardRepository()
+		reviewRepo := new(MockReviewRepository)
+		service := NewGameService(repo, newTestEventRepository(), newTestUserRepository(), leaderboardRepo, reviewRepo, newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+		review := domain.NewGameReview(game, "qiba", domain.RiskAssessment{Score: 0.9}, time.Now())
+		reviewRepo.On("GetReview", game.ID).Return(review, nil)
+		return service, repo, leaderboardRepo, reviewRepo, review
+	}
+
+	t.Run("approving publishes the score", func(t *testing.T) {
+		service, repo, leaderboardRepo, reviewRepo, review := held(t)
+		reviewRepo.On("SaveReview", review).Return(nil).Once()
+
+		game, err := service.ApproveReview("7", review.GameID)
+
+		assert.NoError(t, err)
+		assert.False(t, game.Result.UnderReview)
+		assert.Equal(t, "qiba", game.Result.Leaderboard)
+		assert.NotEmpty(t, game.Result.Receipt)
+		stored, _ := repo.GetGame(review.GameID)
+		assert.Equal(t, game.Result, stored.Result)
+		assert.Equal(t, domain.ReviewApproved, review.Status)
+		assert.Equal(t, "7", review.ReviewedBy)
+		leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 1)
+
+		_, err = service.ApproveReview("7", review.GameID)
+		assert.ErrorIs(t, err, domain.ErrReviewDecided)
+		leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 1)
+	})
+
+	t.Run("rejecting keeps the score withheld", func(t *testing.T) {
+		service, repo, leaderboardRepo, reviewRepo, review := held(t)
+		reviewRepo.On("SaveReview", review).Return(nil).Once()
+
+		game, err := service.RejectReview("7", review.GameID)
+
+		assert.NoError(t, err)
+		assert.False(t, game.Result.UnderReview)
+		assert.True(t, game.Result.Rejected)
+		assert.Empty(t, game.Result.Receipt)
+		assert.Empty(t, game.Result.Leaderboard)
+		stored, _ := repo.GetGame(review.GameID)
+		assert.True(t, stored.Result.Rejected)
+		assert.Equal(t, domain.ReviewRejected, review.Status)
+		leaderboardRepo.AssertNotCalled(t, "AddEntryToLeaderboard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a verdict whose review failed to save can be given again", func(t *testing.T) {
+		service, _, leaderboardRepo, reviewRepo, review := held(t)
+		saved := *review
+		reviewRepo.On("SaveReview", review).Return(errors.New("write failed")).Once()
+
+		_, err := service.ApproveReview("7", review.GameID)
+		assert.Error(t, err)
+
+		// The stored review is still pending, the stored result is approved
+		*review = saved
+		_, err = service.RejectReview("7", review.GameID)
+		assert.ErrorIs(t, err, domain.ErrReviewDecided)
+
+		*review = saved
+		reviewRepo.On("SaveReview", review).Return(nil).Once()
+		game, err := service.ApproveReview("7", review.GameID)
+		assert.NoError(t, err)
+		assert.Equal(t, "qiba", game.Result.Leaderboard)
+		assert.Equal(t, domain.ReviewApproved, review.Status)
+		leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 1)
+	})
+
+	t.Run("only moderators see and decide reviews", func(t *testing.T) {
+		service, _, _, reviewRepo, review := held(t)
+
+		_, err := service.ListReviews("1", domain.ReviewPending, 10)
+		assert.ErrorIs(t, err, domain.ErrNotModerator)
+		_, err = service.ApproveReview("1", review.GameID)
+		assert.ErrorIs(t, err, domain.ErrNotModerator)
+		_, err = service.RejectReview("1", review.GameID)
+		assert.ErrorIs(t, err, domain.ErrNotModerator)
+		reviewRepo.AssertNotCalled(t, "SaveReview", mock.Anything)
+
+		reviewRepo.On("ListReviews", domain.ReviewPending, 10).Return([]*domain.GameReview{review}, nil)
+		reviews, err := service.ListReviews("7", domain.ReviewPending, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.GameReview{review}, reviews)
+	})
+}
+
+func TestScoreVerification(t *testing.T) {
+	// play spawns and taps count objects of a game and returns its event log
+	play := func(t *testing.T, count int) (*GameService, *domain.Game, *[]*domain.GameEvent) {
+		repo := new(MockGameRepository)
+		eventRepo := new(MockGameEventRepository)
+		// Taps sent back to back look automated, the review queue takes them
+		reviewRepo := new(MockReviewRepository)
+		reviewRepo.On("SaveReview", mock.AnythingOfType("*domain.GameReview")).Return(nil).Maybe()
+		service := NewGameService(repo, eventRepo, newTestUserRepository(), newTestLeaderboardRepository(), reviewRepo, newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+		game := domain.NewGameWithSeed("1", 7, &domain.DefaultRules, time.Minute)
+		game.StartTime = time.Now().Add(-30 * time.Second)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+		repo.On("SaveGame", game).Return(nil)
+		repo.On("UpdateGame", game).Return(nil)
+
+		events := new([]*domain.GameEvent)
+		eventRepo.On("AppendEvent", mock.AnythingOfType("*domain.GameEvent")).Run(func(args mock.Arguments) {
+			*events = append(*events, args.Get(0).(*domain.GameEvent))
+		}).Return(nil)
+		getEvents := eventRepo.On("GetEvents", mock.AnythingOfType("string"))
+		getEvents.Run(func(mock.Arguments) {
+			getEvents.ReturnArguments = mock.Arguments{*events, nil}
+		})
+
+		for i := 0; i < count; i++ {
+			obj, err := service.Spawn("game1")
+			assert.NoError(t, err)
+			_, err = service.Tap("game1", obj.ID, time.Now())
+			assert.NoError(t, err)
+		}
+		return service, game, events
+	}
+
+	t.Run("replay matches", func(t *testing.T) {
+		service, game, _ := play(t, 5)
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VerificationVerified, ended.Verification.Status)
+		assert.Equal(t, game.Score, ended.Verification.ReplayScore)
+	})
+
+	t.Run("score changed outside the event log", func(t *testing.T) {
+		service, game, _ := play(t, 5)
+		replayScore := game.Score
+		game.Score += 100
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VerificationMismatch, ended.Verification.Status)
+		assert.Equal(t, replayScore, ended.Verification.ReplayScore)
+		assert.Equal(t, replayScore+100, ended.Score)
+	})
+
+	t.Run("tap verdict differs from the replay", func(t *testing.T) {
+		service, _, events := play(t, 3)
+		for _, event := range *events {
+			if event.Type == domain.GameEventTap {
+				event.TapResult = domain.TapDuplicate
+				break
+			}
+		}
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.False(t, ended.Verification.Verified())
+		assert.Contains(t, ended.Verification.Detail, domain.ErrReplayMismatch.Error())
+	})
+
+	t.Run("event log unavailable", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		eventRepo := new(MockGameEventRepository)
+		service := NewGameService(repo, eventRepo, new(MockUserRepository), new(MockLeaderboardRepository), new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+		game := domain.NewGameWithSeed("1", 7, &domain.DefaultRules, time.Minute)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("UpdateGame", game).Return(nil)
+		eventRepo.On("GetEvents", mock.AnythingOfType("string")).Return(nil, errors.New("store unavailable"))
+		eventRepo.On("AppendEvent", mock.AnythingOfType("*domain.GameEvent")).Return(nil)
+
+		ended, err := service.EndGame("game1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VerificationMismatch, ended.Verification.Status)
+	})
+}
+
+func TestGameOwnership(t *testing.T) {
+	game := domain.NewGameWithSeed("42", 7, &domain.DefaultRules, time.Minute)
+
+	t.Run("owner", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		assert.NoError(t, service.CheckGameOwner("game1", "42"))
+	})
+
+	t.Run("another user", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		assert.ErrorIs(t, service.CheckGameOwner("game1", "43"), domain.ErrNotGameOwner)
+	})
+
+	t.Run("leaderboard user is the recorded owner", func(t *testing.T) {
+		service, userRepo := newTestGameService(new(MockGameRepository), newTestEncrypter())
+		userRepo.On("Get", "42").Return(&domain.User{UserId: 42, Username: "owner"}, nil)
+
+		owner, err := service.GameOwner(game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), owner.UserId)
+		assert.Equal(t, "owner", owner.Username)
+	})
+
+	t.Run("owner missing from the user repository", func(t *testing.T) {
+		service, userRepo := newTestGameService(new(MockGameRepository), newTestEncrypter())
+		userRepo.On("Get", "42").Return(nil, errors.New("user not found"))
+
+		owner, err := service.GameOwner(game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.User{UserId: 42}, owner)
+	})
+}
+
+func TestReceipt(t *testing.T) {
+	service, _ := newTestGameService(new(MockGameRepository), newTestEncrypter())
+	ended := func() *domain.Game {
+		game := domain.NewGameWithSeed("42", 7, &domain.DefaultRules, time.Minute)
+		game.Mode = domain.GameModeTimed
+		game.Score = 12
+		assert.NoError(t, game.End(time.Now()))
+		game.Verification = domain.ScoreVerification{Status: domain.VerificationVerified, ReplayScore: 12}
+		return game
+	}
+
+	t.Run("verified score", func(t *testing.T) {
+		game := ended()
+
+		token, err := service.Receipt(game)
+
+		assert.NoError(t, err)
+		r, err := receipt.Verify(token, service.ReceiptKeys()...)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", r.KeyID)
+		assert.Equal(t, "42", r.UserID)
+		assert.Equal(t, game.ID, r.GameID)
+		assert.Equal(t, int32(12), r.Score)
+		assert.Equal(t, domain.GameModeTimed, r.Mode)
+		assert.True(t, game.EndTime.Equal(r.EndTime))
+	})
+
+	t.Run("game not ended", func(t *testing.T) {
+		token, err := service.Receipt(domain.NewGameWithSeed("42", 7, &domain.DefaultRules, time.Minute))
+
+		assert.ErrorIs(t, err, domain.ErrGameNotEnded)
+		assert.Empty(t, token)
+	})
+
+	t.Run("unverified score", func(t *testing.T) {
+		game := ended()
+		game.Verification.Status = domain.VerificationMismatch
+
+		token, err := service.Receipt(game)
+
+		assert.ErrorIs(t, err, domain.ErrScoreUnverified)
+		assert.Empty(t, token)
+	})
+}
+
+func TestListGames(t *testing.T) {
+	t.Run("passes the query to the repository", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+
+		query := domain.GameQuery{UserID: "1", Limit: 2}
+		page := &domain.GamePage{Games: []*domain.Game{{ID: "game1"}}}
+		repo.On("ListGames", query).Return(page, nil)
+
+		result, err := service.ListGames(query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+		now := time.Now()
+
+		_, err := service.ListGames(domain.GameQuery{})
+		assert.ErrorIs(t, err, domain.ErrInvalidGameQuery)
+		_, err = service.ListGames(domain.GameQuery{UserID: "1", From: now, To: now.Add(-time.Hour)})
+		assert.ErrorIs(t, err, domain.ErrInvalidGameQuery)
+		_, err = domain.DecodeGameCursor("not a token")
+		assert.ErrorIs(t, err, domain.ErrInvalidGameQuery)
+		repo.AssertNotCalled(t, "ListGames", mock.Anything)
+	})
+
+}
+
+func TestStreamSpawns(t *testing.T) {
+	rules := domain.Rules{
+		Version:         "fast-1",
+		Mode:            domain.DefaultGameMode,
+		SpawnIntervalMs: 100,
+		Objects:         []domain.ObjectRule{{Type: "a", Weight: 1, Points: 1}},
+	}
+	book, err := domain.NewRuleBook([]domain.Rules{rules})
+	assert.NoError(t, err)
+
+	newService := func(games ...*domain.Game) (*GameService, *copyingGameRepository, *MockLeaderboardRepository) {
+		repo := newCopyingGameRepository(games...)
+		leaderboardRepo := new(MockLeaderboardRepository)
+		board := domain.NewLeaderboard("qiba")
+		leaderboardRepo.On("GetLeaderboard", "qiba").Return(board, nil)
+		leaderboardRepo.On("AddEntryToLeaderboard", board, mock.AnythingOfType("*domain.GameEntry")).Return(nil)
+		repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+		service := NewGameService(repo, newTestEventRepository(), newTestUserRepository(), leaderboardRepo, new(MockReviewRepository), newTestEncrypter(), newTestSigner(), book, newTestModes(), testReplayDelay)
+		return service, repo, leaderboardRepo
+	}
+
+	t.Run("objects are sent on the server clock until the game runs out", func(t *testing.T) {
+		game := domain.NewGameWithSeed("1", 42, &rules, 450*time.Millisecond)
+		service, repo, leaderboardRepo := newService(game)
+
+		var sent []domain.GameObject
+		final, err := service.StreamSpawns(context.Background(), game.ID, func(obj *domain.GameObject) error {
+			assert.False(t, time.Now().Before(obj.Timestamp))
+			sent = append(sent, *obj)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, sent, 5)
+		stored, _ := repo.GetGame(game.ID)
+		assert.Equal(t, stored.ObjectSeq[:5], sent)
+		assert.False(t, time.Now().Before(game.ExpiresAt))
+		assert.Equal(t, domain.GameStatusEnded, final.Status)
+		assert.Equal(t, game.ExpiresAt, final.EndTime)
+		assert.True(t, final.IsFinalized())
+		assert.Equal(t, domain.GameStatusEnded, stored.Status)
+		assert.Equal(t, final.Result, stored.Result)
+		leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 1)
+	})
+
+	t.Run("ends the game when the mistakes run out", func(t *testing.T) {
+		game := domain.NewGameWithSeed("1", 42, &rules, time.Minute)
+		game.MaxMistakes = 3
+		game.Mistakes = 3
+		service, repo, _ := newService(game)
+
+		final, err := service.StreamSpawns(context.Background(), game.ID, func(obj *domain.GameObject) error {
+			t.Fatal("no object is sent once the mistakes ran out")
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GameStatusEnded, final.Status)
+		assert.True(t, final.IsFinalized())
+		stored, _ := repo.GetGame(game.ID)
+		assert.Equal(t, domain.GameStatusEnded, stored.Status)
+	})
+
+	t.Run("stops when the client goes away", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service := NewGameService(repo, newTestEventRepository(), new(MockUserRepository), new(MockLeaderboardRepository), new(MockReviewRepository), newTestEncrypter(), newTestSigner(), book, newTestModes(), testReplayDelay)
+
+		game := domain.NewGameWithSeed("1", 42, &rules, time.Minute)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		count := 0
+		_, err := service.StreamSpawns(ctx, "game1", func(obj *domain.GameObject) error {
+			count++
+			if count == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestGameReaper(t *testing.T) {
+	now := time.Now()
+	expired := func(userID string, seed int64) *domain.Game {
+		game := domain.NewGameWithSeed(userID, seed, &domain.DefaultRules, time.Minute)
+		game.StartTime = now.Add(-2 * time.Minute)
+		game.ExpiresAt = now.Add(-time.Minute)
+		game.Status = domain.GameStatusRunning
+		return game
+	}
+	abandoned := expired("1", 1)
+	ended := expired("1", 3)
+	inGrace := domain.NewGameWithSeed("1", 2, &domain.DefaultRules, time.Minute)
+	inGrace.ExpiresAt = now.Add(-time.Second)
+	inGrace.Status = domain.GameStatusRunning
+
+	repo := newCopyingGameRepository(abandoned, ended, inGrace)
+	leaderboardRepo := new(MockLeaderboardRepository)
+	service := NewGameService(repo, newTestEventRepository(), newTestUserRepository(), leaderboardRepo, new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+	board := domain.NewLeaderboard("qiba")
+	leaderboardRepo.On("GetLeaderboard", "qiba").Return(board, nil)
+	leaderboardRepo.On("AddEntryToLeaderboard", board, mock.AnythingOfType("*domain.GameEntry")).Return(nil)
+	repo.On("ListGames", mock.AnythingOfType("domain.GameQuery")).Return(&domain.GamePage{}, nil)
+	// The reaper loads its copies before EndGame finalizes one of the games
+	stale := []*domain.Game{abandoned, ended, inGrace}
+	repo.On("GetExpiredGames", now).Return(stale, nil)
+	endedResult, err := service.EndGame(ended.ID)
+	assert.NoError(t, err)
+
+	count, err := NewGameReaper(service, time.Minute).Reap(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	reaped, _ := repo.GetGame(abandoned.ID)
+	assert.Equal(t, domain.GameStatusExpired, reaped.Status)
+	assert.Equal(t, abandoned.ExpiresAt, reaped.EndTime)
+	assert.True(t, reaped.Verification.Verified())
+	assert.True(t, reaped.IsFinalized())
+	assert.Equal(t, "qiba", reaped.Result.Leaderboard)
+	stillEnded, _ := repo.GetGame(ended.ID)
+	assert.Equal(t, domain.GameStatusEnded, stillEnded.Status)
+	assert.Equal(t, endedResult.Result, stillEnded.Result)
+	waiting, _ := repo.GetGame(inGrace.ID)
+	assert.Equal(t, domain.GameStatusRunning, waiting.Status)
+	leaderboardRepo.AssertNumberOfCalls(t, "AddEntryToLeaderboard", 2)
+}
+
+func TestSpawn(t *testing.T) {
+	t.Run("sequence is a function of the seed", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		first := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		second := domain.NewGameWithSeed("2", 42, &domain.DefaultRules, time.Minute)
+		second.StartTime = first.StartTime
+
+		repo.On("GetGame", "first").Return(first, nil)
+		repo.On("GetGame", "second").Return(second, nil)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		for i := 0; i < 5; i++ {
+			a, err := service.Spawn("first")
+			assert.NoError(t, err)
+			b, err := service.Spawn("second")
+			assert.NoError(t, err)
+			assert.Equal(t, a, b)
+		}
+		assert.Len(t, first.ObjectSeq, 5)
+	})
+
+	t.Run("objects follow the game's rules", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		rules := domain.Rules{
+			Version:         "penalties-1",
+			Mode:            domain.DefaultGameMode,
+			SpawnIntervalMs: 500,
+			Objects: []domain.ObjectRule{
+				{Type: "bomb", Weight: 1, Points: -3, LifetimeMs: 1500},
+			},
+		}
+		book, err := domain.NewRuleBook([]domain.Rules{domain.DefaultRules, rules})
+		assert.NoError(t, err)
+		service := NewGameService(repo, newTestEventRepository(), new(MockUserRepository), new(MockLeaderboardRepository), new(MockReviewRepository), encrypter, newTestSigner(), book, newTestModes(), testReplayDelay)
+
+		game := domain.NewGameWithSeed("1", 42, &rules, time.Minute)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("SaveGame", mock.AnythingOfType("*domain.Game")).Return(nil)
+
+		first, err := service.Spawn("game1")
+		assert.NoError(t, err)
+		second, err := service.Spawn("game1")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "penalties-1", game.RulesVersion)
+		assert.Equal(t, "bomb", first.Type)
+		assert.Equal(t, int32(-3), first.Points)
+		assert.Equal(t, 1500*time.Millisecond, first.Lifetime)
+		assert.Equal(t, 500*time.Millisecond, second.Timestamp.Sub(first.Timestamp))
+	})
+
+	t.Run("unknown rules version", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		game.RulesVersion = "retired-1"
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		obj, err := service.Spawn("game1")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownRulesVersion)
+		assert.Nil(t, obj)
+	})
+
+	t.Run("unknown generator version", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		encrypter := new(MockEncrypter)
+		service, _ := newTestGameService(repo, encrypter)
+
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		game.GeneratorVersion = 99
+		repo.On("GetGame", "game1").Return(game, nil)
+
+		obj, err := service.Spawn("game1")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownGeneratorVersion)
+		assert.Nil(t, obj)
+		assert.Empty(t, game.ObjectSeq)
+	})
+
+	t.Run("no objects are spawned past ExpiresAt", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		service, _ := newTestGameService(repo, newTestEncrypter())
+
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, 2*time.Second)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("SaveGame", game).Return(nil)
+
+		var err error
+		for i := 0; i < 10 && err == nil; i++ {
+			_, err = service.Spawn("game1")
+		}
+		assert.ErrorIs(t, err, domain.ErrNoObjectsLeft)
+		spawned := len(game.ObjectSeq)
+		assert.NotZero(t, spawned)
+		for _, obj := range game.ObjectSeq {
+			assert.False(t, obj.Timestamp.After(game.ExpiresAt))
+		}
+
+		// Asking again neither grows nor stores the sequence
+		obj, err := service.Spawn("game1")
+
+		assert.ErrorIs(t, err, domain.ErrNoObjectsLeft)
+		assert.Nil(t, obj)
+		assert.Len(t, game.ObjectSeq, spawned)
+		repo.AssertNumberOfCalls(t, "SaveGame", spawned)
+	})
+
+	t.Run("saving the game fails", func(t *testing.T) {
+		repo := new(MockGameRepository)
+		eventRepo := new(MockGameEventRepository)
+		service := NewGameService(repo, eventRepo, new(MockUserRepository), new(MockLeaderboardRepository), new(MockReviewRepository), newTestEncrypter(), newTestSigner(), domain.DefaultRuleBook(), newTestModes(), testReplayDelay)
+
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		repo.On("GetGame", "game1").Return(game, nil)
+		repo.On("SaveGame", game).Return(assert.AnError)
+
+		obj, err := service.Spawn("game1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, obj)
+		eventRepo.AssertNotCalled(t, "AppendEvent", mock.Anything)
 	})
 }
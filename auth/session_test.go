@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testSecret  = []byte("0123456789abcdef0123456789abcdef")
+	otherSecret = []byte("fedcba9876543210fedcba9876543210")
+)
+
+// newTestIssuer issues and verifies at the clock's time
+func newTestIssuer(t *testing.T, secret []byte, clock *time.Time) *Issuer {
+	issuer, err := NewIssuer(secret, DefaultSessionTTL)
+	assert.NoError(t, err)
+	issuer.now = func() time.Time { return *clock }
+	return issuer
+}
+
+func TestNewIssuer(t *testing.T) {
+	_, err := NewIssuer(testSecret[:MinSecretSize-1], DefaultSessionTTL)
+	assert.Error(t, err)
+
+	_, err = NewIssuer(testSecret, DefaultSessionTTL)
+	assert.NoError(t, err)
+}
+
+func TestSession(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("an issued token verifies", func(t *testing.T) {
+		clock := start
+		issuer := newTestIssuer(t, testSecret, &clock)
+
+		token, issued, err := issuer.Issue(Session{UserID: 42, Username: "ada"})
+		assert.NoError(t, err)
+		assert.Equal(t, start, issued.IssuedAt)
+		assert.Equal(t, start.Add(DefaultSessionTTL), issued.ExpiresAt)
+
+		clock = start.Add(DefaultSessionTTL - time.Second)
+		session, err := issuer.Verify(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, issued, session)
+	})
+
+	t.Run("a token expires after the TTL", func(t *testing.T) {
+		clock := start
+		issuer := newTestIssuer(t, testSecret, &clock)
+		token, _, err := issuer.Issue(Session{UserID: 42})
+		assert.NoError(t, err)
+
+		clock = start.Add(DefaultSessionTTL)
+		_, err = issuer.Verify(token)
+
+		assert.ErrorIs(t, err, ErrTokenExpired)
+	})
+
+	t.Run("a tampered payload fails the signature", func(t *testing.T) {
+		clock := start
+		issuer := newTestIssuer(t, testSecret, &clock)
+		token, _, err := issuer.Issue(Session{UserID: 42})
+		assert.NoError(t, err)
+		encoded, mac, _ := strings.Cut(token, ".")
+		payload, err := base64.RawURLEncoding.DecodeString(encoded)
+		assert.NoError(t, err)
+		forged := strings.Replace(string(payload), `"uid":42`, `"uid":7`, 1)
+		assert.NotEqual(t, string(payload), forged)
+
+		_, err = issuer.Verify(base64.RawURLEncoding.EncodeToString([]byte(forged)) + "." + mac)
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("a tampered signature is refused", func(t *testing.T) {
+		clock := start
+		issuer := newTestIssuer(t, testSecret, &clock)
+		token, _, err := issuer.Issue(Session{UserID: 42})
+		assert.NoError(t, err)
+		encoded, encodedMac, _ := strings.Cut(token, ".")
+		mac, err := base64.RawURLEncoding.DecodeString(encodedMac)
+		assert.NoError(t, err)
+		mac[0] ^= 1
+
+		_, err = issuer.Verify(encoded + "." + base64.RawURLEncoding.EncodeToString(mac))
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("a token signed with another key is refused", func(t *testing.T) {
+		clock := start
+		token, _, err := newTestIssuer(t, otherSecret, &clock).Issue(Session{UserID: 42})
+		assert.NoError(t, err)
+
+		_, err = newTestIssuer(t, testSecret, &clock).Verify(token)
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("a malformed token is refused", func(t *testing.T) {
+		clock := start
+		issuer := newTestIssuer(t, testSecret, &clock)
+
+		for _, token := range []string{"", "no-dot", ".", "a.b"} {
+			_, err := issuer.Verify(token)
+			assert.ErrorIs(t, err, ErrInvalidToken, token)
+		}
+	})
+}
+
+func TestContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	session := &Session{UserID: 42}
+	found, ok := FromContext(NewContext(context.Background(), session))
+	assert.True(t, ok)
+	assert.Same(t, session, found)
+}
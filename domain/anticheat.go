@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// DefaultRiskThreshold is the risk score from which a game is held for review
+// instead of being published
+const DefaultRiskThreshold = 0.8
+
+const (
+	// Signals based on taps need this many before they mean anything
+	MinRiskSamples = 5
+	// A score is only compared with the player's history once they have
+	// played this many games of the mode
+	MinHistoryGames = 3
+)
+
+// Names of the signals the anti-cheat analyzer looks at
+const (
+	RiskTapCadence   = "tap_cadence"
+	RiskReactionTime = "reaction_time"
+	RiskTapAccuracy  = "tap_accuracy"
+	RiskScoreHistory = "score_history"
+)
+
+// RiskSignal is one reason a game looks automated or implausible. Its score
+// runs from 0, nothing unusual, to 1, almost certainly not a fair game.
+type RiskSignal struct {
+	Name   string  `bson:"Name"`
+	Score  float64 `bson:"Score"`
+	Detail string  `bson:"Detail"`
+}
+
+// RiskAssessment is the anti-cheat verdict for a game. Its score is the
+// chance that at least one of its signals is right, so one strong signal is
+// enough but several weak ones add up.
+type RiskAssessment struct {
+	Score   float64      `bson:"Score"`
+	Signals []RiskSignal `bson:"Signals"`
+}
+
+// Exceeds reports whether the game should be held for review at threshold
+func (r RiskAssessment) Exceeds(threshold float64) bool {
+	return r.Score >= threshold
+}
+
+// AnalyzeGame scores how likely the ended game was not played fairly from its
+// event log and the player's earlier games. Earlier games only count once
+// their verified result was published.
+func AnalyzeGame(game *Game, events []*GameEvent, history []*Game) RiskAssessment {
+	signals := []RiskSignal{
+		tapCadenceRisk(events),
+		reactionTimeRisk(events),
+		tapAccuracyRisk(events),
+		scoreHistoryRisk(game, history),
+	}
+	fair := 1.0
+	for _, signal := range signals {
+		fair *= 1 - signal.Score
+	}
+	return RiskAssessment{Score: 1 - fair, Signals: signals}
+}
+
+// tapCadenceRisk flags taps spaced too evenly. Objects spawn with jitter and
+// people react unevenly, so the gaps between their taps vary.
+func tapCadenceRisk(events []*GameEvent) RiskSignal {
+	signal := RiskSignal{Name: RiskTapCadence}
+	var taps []time.Time
+	for _, event := range events {
+		if event.Type == GameEventTap && event.TapResult == TapAccepted {
+			taps = append(taps, event.TappedAt)
+		}
+	}
+	if len(taps) < MinRiskSamples {
+		signal.Detail = "not enough taps"
+		return signal
+	}
+	slices.SortFunc(taps, func(a, b time.Time) int { return a.Compare(b) })
+	gaps := make([]float64, 0, len(taps)-1)
+	for i := 1; i < len(taps); i++ {
+		gaps = append(gaps, float64(taps[i].Sub(taps[i-1]).Milliseconds()))
+	}
+	mean, stdDev := meanStdDev(gaps)
+	if mean <= 0 {
+		signal.Score = 1
+		signal.Detail = "taps without gaps"
+		return signal
+	}
+	// A coefficient of variation of 3% or less is machine-like, 15% or more
+	// is ordinary
+	cv := stdDev / mean
+	signal.Score = scale(cv, 0.15, 0.03)
+	signal.Detail = fmt.Sprintf("tap gaps vary by %.1f%% over %d taps", cv*100, len(taps))
+	return signal
+}
+
+// reactionTimeRisk flags reaction times that are faster or steadier than
+// people manage over a whole game
+func reactionTimeRisk(events []*GameEvent) RiskSignal {
+	signal := RiskSignal{Name: RiskReactionTime}
+	var reactions []time.Duration
+	for _, event := range events {
+		if event.Type == GameEventTap && event.TapResult == TapAccepted {
+			reactions = append(reactions, event.ReactionTime)
+		}
+	}
+	if len(reactions) < MinRiskSamples {
+		signal.Detail = "not enough taps"
+		return signal
+	}
+	stats := NewReactionStats(reactions)
+	medianMs := float64(stats.Median.Milliseconds())
+	stdDevMs := math.Sqrt(stats.Variance)
+	suspiciousMs := float64(SuspiciousMedianReaction.Milliseconds())
+	steadyMs := float64(SuspiciousReactionStdDev.Milliseconds())
+	signal.Score = math.Max(
+		scale(medianMs, suspiciousMs, suspiciousMs*2/3),
+		scale(stdDevMs, steadyMs*2, steadyMs),
+	)
+	signal.Detail = fmt.Sprintf("median reaction %.0fms, deviation %.0fms over %d taps", medianMs, stdDevMs, len(reactions))
+	return signal
+}
+
+// tapAccuracyRisk flags games that score nearly every object worth points
+// while never touching a penalty object
+func tapAccuracyRisk(events []*GameEvent) RiskSignal {
+	signal := RiskSignal{Name: RiskTapAccuracy}
+	points := make(map[string]int32)
+	var scoring, penalties int
+	for _, event := range events {
+		if event.Type == GameEventSpawn {
+			points[event.ObjectID] = event.Points
+			if event.Points > 0 {
+				scoring++
+			} else if event.Points < 0 {
+				penalties++
+			}
+		}
+	}
+	if scoring < MinRiskSamples || penalties == 0 {
+		signal.Detail = "not enough objects"
+		return signal
+	}
+	var hits, penaltyHits int
+	for _, event := range events {
+		if event.Type != GameEventTap || event.TapResult != TapAccepted {
+			continue
+		}
+		if points[event.ObjectID] > 0 {
+			hits++
+		} else if points[event.ObjectID] < 0 {
+			penaltyHits++
+		}
+	}
+	hitRate := float64(hits) / float64(scoring)
+	avoided := 1 - float64(penaltyHits)/float64(penalties)
+	// Perfect play is only suspicious when there were penalties to avoid
+	signal.Score = scale(hitRate, 0.9, 1) * scale(avoided, 0.9, 1) * scale(float64(penalties), 0, 3)
+	signal.Detail = fmt.Sprintf("scored %d of %d objects, tapped %d of %d penalties", hits, scoring, penaltyHits, penalties)
+	return signal
+}
+
+// scoreHistoryRisk flags scores far above what the player usually scores in
+// the mode
+func scoreHistoryRisk(game *Game, history []*Game) RiskSignal {
+	signal := RiskSignal{Name: RiskScoreHistory}
+	var scores []float64
+	for _, past := range history {
+		if past.ID == game.ID || past.Mode != game.Mode || past.Result == nil || !past.Result.Verified || past.Result.UnderReview {
+			continue
+		}
+		scores = append(scores, float64(past.Result.Score))
+	}
+	if len(scores) < MinHistoryGames {
+		signal.Detail = "not enough earlier games"
+		return signal
+	}
+	mean, stdDev := meanStdDev(scores)
+	// Players improve, so allow for a spread of at least a quarter of their
+	// usual score
+	spread := math.Max(stdDev, math.Max(math.Abs(mean)/4, 5))
+	z := (float64(game.Score) - mean) / spread
+	signal.Score = scale(z, 3, 6)
+	signal.Detail = fmt.Sprintf("score %d against an average of %.1f over %d games", game.Score, mean, len(scores))
+	return signal
+}
+
+// scale maps value linearly from 0 at from to 1 at to, clamped to 0-1
+func scale(value, from, to float64) float64 {
+	return math.Min(1, math.Max(0, (value-from)/(to-from)))
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskSampleBoundary(t *testing.T) {
+	// taps returns count accepted taps a bot made exactly 500ms apart, each
+	// 150ms after its object appeared
+	taps := func(count int) []*GameEvent {
+		start := time.Now()
+		events := make([]*GameEvent, 0, count)
+		for i := 0; i < count; i++ {
+			events = append(events, &GameEvent{
+				Type:         GameEventTap,
+				TapResult:    TapAccepted,
+				TappedAt:     start.Add(time.Duration(i) * 500 * time.Millisecond),
+				ReactionTime: 150 * time.Millisecond,
+			})
+		}
+		return events
+	}
+
+	for _, signal := range []func([]*GameEvent) RiskSignal{tapCadenceRisk, reactionTimeRisk} {
+		tooFew := signal(taps(MinRiskSamples - 1))
+		assert.Zero(t, tooFew.Score, tooFew.Name)
+		assert.Equal(t, "not enough taps", tooFew.Detail, tooFew.Name)
+
+		enough := signal(taps(MinRiskSamples))
+		assert.Equal(t, 1.0, enough.Score, enough.Name)
+	}
+}
+
+func TestAnalyzeGameScoreHistory(t *testing.T) {
+	past := func(id string, score int32) *Game {
+		return &Game{ID: id, Mode: "timed", Result: &GameResult{Score: score, Verified: true}}
+	}
+	history := []*Game{past("a", 20), past("b", 24), past("c", 22)}
+
+	usual := AnalyzeGame(&Game{ID: "d", Mode: "timed", Score: 26}, nil, history)
+	assert.False(t, usual.Exceeds(DefaultRiskThreshold))
+
+	jump := AnalyzeGame(&Game{ID: "d", Mode: "timed", Score: 90}, nil, history)
+	assert.True(t, jump.Exceeds(DefaultRiskThreshold))
+
+	// Scores held for review don't count as history
+	for _, game := range history {
+		game.Result.UnderReview = true
+	}
+	unknown := AnalyzeGame(&Game{ID: "d", Mode: "timed", Score: 90}, nil, history)
+	assert.Zero(t, unknown.Score)
+}
@@ -1,51 +1,340 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+)
+
+// GameStatus is the lifecycle state of a game. Games move from created to
+// running on the first spawn, and finish as ended (EndGame was called) or
+// expired (the duration ran out and the game was never ended).
+type GameStatus string
+
+const (
+	GameStatusCreated GameStatus = "created"
+	GameStatusRunning GameStatus = "running"
+	GameStatusEnded   GameStatus = "ended"
+	GameStatusExpired GameStatus = "expired"
+)
 
-	"math/rand"
+// GameGracePeriod allows for requests that were in flight when the game ran out
+const GameGracePeriod = 2 * time.Second
+
+var (
+	ErrGameExpired = errors.New("game has expired")
+	ErrGameEnded   = errors.New("game has already ended")
+	// The game's mode allows no more mistakes, it only waits to be ended
+	ErrNoMistakesLeft = errors.New("game has no mistakes left")
+	ErrGameNotEnded   = errors.New("game has not ended")
+	ErrNotGameOwner   = errors.New("game belongs to another user")
+	// The next object of the sequence would appear after the game expires
+	ErrNoObjectsLeft = errors.New("game has no objects left before it expires")
 )
 
 type Game struct {
-	ID        string       `bson:"ID"`
-	Score     int32        `bson:"Score"`
-	ObjectSeq []GameObject `bson:"ObjectSeq"`
-	StartTime time.Time    `bson:"StartTime"`
-	EndTime   time.Time    `bson:"EndTime"`
-	UserID    string       `bson:"UserID"`
+	ID               string       `bson:"ID"`
+	Score            int32        `bson:"Score"`
+	ObjectSeq        []GameObject `bson:"ObjectSeq"`
+	StartTime        time.Time    `bson:"StartTime"`
+	EndTime          time.Time    `bson:"EndTime"`
+	ExpiresAt        time.Time    `bson:"ExpiresAt"`
+	Status           GameStatus   `bson:"Status"`
+	Streak           int32        `bson:"Streak"`
+	Mistakes         int32        `bson:"Mistakes"`
+	MaxMistakes      int32        `bson:"MaxMistakes"`
+	UserID           string       `bson:"UserID"`
+	Seed             int64        `bson:"Seed"`
+	GeneratorVersion int32        `bson:"GeneratorVersion"`
+	Mode             string       `bson:"Mode"`
+	RulesVersion     string       `bson:"RulesVersion"`
+	// Practice games use no plays and are never published
+	Practice bool          `bson:"Practice"`
+	Reaction ReactionStats `bson:"Reaction"`
+	// Set when the game ends by replaying its event log
+	Verification ScoreVerification `bson:"Verification"`
+	// Set once the ended game's score has been published, nil until then
+	Result *GameResult `bson:"Result,omitempty"`
+	// Set when the anti-cheat analyzer looked at the ended game
+	Risk *RiskAssessment `bson:"Risk,omitempty"`
+}
+
+// GameResult is what ending a game produced. It is stored so that ending
+// the game again returns the same result instead of publishing it twice.
+type GameResult struct {
+	Score    int32  `bson:"Score"`
+	Verified bool   `bson:"Verified"`
+	Receipt  string `bson:"Receipt,omitempty"`
+	// Board the score was posted to, empty when it was not posted
+	Leaderboard string `bson:"Leaderboard,omitempty"`
+	// The score was held for a moderator instead of being published
+	UnderReview bool `bson:"UnderReview,omitempty"`
+	// A moderator rejected the held score, it is never published
+	Rejected    bool      `bson:"Rejected,omitempty"`
+	FinalizedAt time.Time `bson:"FinalizedAt"`
 }
 
 type GameObject struct {
-	ID        string    `bson:"ID"`
-	Type      string    `bson:"Type"`
-	Timestamp time.Time `bson:"Timestamp"`
+	ID        string        `bson:"ID"`
+	Type      string        `bson:"Type"`
+	Timestamp time.Time     `bson:"Timestamp"`
+	Lifetime  time.Duration `bson:"Lifetime"`
+	Points    int32         `bson:"Points"`
+	Tapped    bool          `bson:"Tapped"`
+	TappedAt  time.Time     `bson:"TappedAt"`
+	// Time between the object spawning and the player tapping it
+	ReactionTime time.Duration `bson:"ReactionTime"`
+}
+
+// GameTicket identifies a game to its client. It is handed out encrypted
+// when the game starts and echoed back when it ends.
+type GameTicket struct {
+	GameID    string    `json:"game_id"`
+	UserID    string    `json:"user_id"`
+	Mode      string    `json:"mode"`
+	StartTime time.Time `json:"start_time"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// TapResult is the verdict for a single tap
+type TapResult string
+
+const (
+	// The tap scored the object
+	TapAccepted TapResult = "accepted"
+	// The object was already tapped, the tap did not score
+	TapDuplicate TapResult = "duplicate"
+	// The object was not visible yet or had already disappeared
+	TapMiss TapResult = "miss"
+	// The object is not part of the game
+	TapUnknownObject TapResult = "unknown_object"
+	// The tap came faster than a person can react
+	TapTooFast TapResult = "too_fast"
+)
+
+// TapOutcome is the verdict for a tap together with the game state after it
+type TapOutcome struct {
+	Result       TapResult
+	Score        int32
+	Streak       int32
+	ReactionTime time.Duration
+	Timestamp    time.Time
+}
+
+// Generate a new game of mode with a server generated seed. rules must be
+// the active rules for the mode.
+func NewGame(userId string, mode *GameMode, rules *Rules) *Game {
+	game := NewGameWithSeed(userId, NewSeed(), rules, mode.Duration)
+	game.Mode = mode.Name
+	game.MaxMistakes = mode.MaxMistakes
+	game.Practice = mode.Practice
+	return game
 }
 
-// Generate a new game with random object sequence
-func NewGame(userId string) *Game {
+// Generate a new game whose object sequence is derived from seed. Games
+// sharing a seed and rules see the same objects at the same offsets.
+func NewGameWithSeed(userId string, seed int64, rules *Rules, duration time.Duration) *Game {
+	// Stores keep millisecond precision, truncate so the sequence can be
+	// regenerated from a persisted game.
+	now := time.Now().Truncate(time.Millisecond)
 	game := &Game{
-		ID:        uuid.New().String(),
-		StartTime: time.Now(),
-		UserID:    userId,
-		EndTime:   time.Now(),
+		ID:               uuid.New().String(),
+		StartTime:        now,
+		UserID:           userId,
+		EndTime:          now,
+		ExpiresAt:        now.Add(duration),
+		Status:           GameStatusCreated,
+		Seed:             seed,
+		GeneratorVersion: CurrentGeneratorVersion,
+		Mode:             rules.Mode,
+		RulesVersion:     rules.Version,
 	}
 	return game
 }
 
-// Generates a random sequence of objects for the game
-func (g *Game) GenerateObjectSequence() {
-	// Populate with 10 sample objects
+func (g *Game) Ticket() GameTicket {
+	return GameTicket{GameID: g.ID, UserID: g.UserID, Mode: g.Mode, StartTime: g.StartTime, ExpiresAt: g.ExpiresAt}
+}
+
+// CheckOwner returns ErrNotGameOwner unless the game was started by userID
+func (g *Game) CheckOwner(userID string) error {
+	if g.UserID != userID {
+		return ErrNotGameOwner
+	}
+	return nil
+}
+
+// IsFinalized reports whether the game's result has been published
+func (g *Game) IsFinalized() bool {
+	return g.Result != nil
+}
+
+// IsOpen reports whether the game has not been ended or expired yet
+func (g *Game) IsOpen() bool {
+	return g.Status == GameStatusCreated || g.Status == GameStatusRunning
+}
+
+// IsExpired reports whether the game ran past its duration at now
+func (g *Game) IsExpired(now time.Time) bool {
+	return now.After(g.ExpiresAt.Add(GameGracePeriod))
+}
+
+// CheckPlayable returns an error when spawns and taps are no longer accepted.
+// A game found past its duration is marked as expired.
+func (g *Game) CheckPlayable(now time.Time) error {
+	if !g.IsOpen() {
+		if g.Status == GameStatusExpired {
+			return ErrGameExpired
+		}
+		return ErrGameEnded
+	}
+	if g.IsExpired(now) {
+		g.Expire()
+		return ErrGameExpired
+	}
+	if g.OutOfMistakes() {
+		return ErrNoMistakesLeft
+	}
+	return nil
+}
+
+// OutOfMistakes reports whether the game reached its mode's mistake limit
+func (g *Game) OutOfMistakes() bool {
+	return g.MaxMistakes > 0 && g.Mistakes >= g.MaxMistakes
+}
+
+// Start moves a created game to running
+func (g *Game) Start() {
+	if g.Status == GameStatusCreated {
+		g.Status = GameStatusRunning
+	}
+}
+
+// End finishes an open or expired game. The end time never extends past the
+// game's duration.
+func (g *Game) End(now time.Time) error {
+	if g.Status == GameStatusEnded {
+		return ErrGameEnded
+	}
+	g.Status = GameStatusEnded
+	g.EndTime = now
+	if now.After(g.ExpiresAt) {
+		g.EndTime = g.ExpiresAt
+	}
+	return nil
+}
+
+// Expire finishes a game that was never ended
+func (g *Game) Expire() {
+	if !g.IsOpen() {
+		return
+	}
+	g.Status = GameStatusExpired
+	g.EndTime = g.ExpiresAt
+}
+
+// Tap scores objectID at most once. tappedAt is when the player tapped,
+// now is when the server received the tap. Objects can only be tapped while
+// they are visible, and no sooner after spawning than rules allow a person
+// to react. Scoring taps extend the streak, penalties and misses reset it
+// and count as mistakes.
+func (g *Game) Tap(objectID string, tappedAt, now time.Time, rules *Rules) TapOutcome {
+	// Stores keep millisecond precision, truncate so a replay of the stored
+	// tap reaches the same verdict
+	tappedAt = tappedAt.Truncate(time.Millisecond)
+	now = now.Truncate(time.Millisecond)
+	// The player cannot have tapped after the server received the tap
+	if tappedAt.IsZero() || tappedAt.After(now) {
+		tappedAt = now
+	}
+	result, reaction := g.tap(objectID, tappedAt, now, rules)
+	return TapOutcome{Result: result, Score: g.Score, Streak: g.Streak, ReactionTime: reaction, Timestamp: now}
+}
 
-	isTypeA := rand.Intn(2) == 0
+func (g *Game) tap(objectID string, tappedAt, now time.Time, rules *Rules) (TapResult, time.Duration) {
+	for i := range g.ObjectSeq {
+		obj := &g.ObjectSeq[i]
+		if obj.ID != objectID {
+			continue
+		}
+		if obj.Tapped {
+			return TapDuplicate, obj.ReactionTime
+		}
+		reaction := tappedAt.Sub(obj.Timestamp)
+		if !obj.VisibleAt(now) || !obj.VisibleAt(tappedAt) {
+			g.Streak = 0
+			g.Mistakes++
+			return TapMiss, reaction
+		}
+		if rules != nil && reaction < rules.MinReaction() {
+			return TapTooFast, reaction
+		}
+		obj.Tapped = true
+		obj.TappedAt = now
+		obj.ReactionTime = reaction
+		g.Score += obj.Points
+		if obj.Points > 0 {
+			g.Streak++
+		} else {
+			g.Streak = 0
+		}
+		if obj.Points < 0 {
+			g.Mistakes++
+		}
+		g.updateReactionStats()
+		return TapAccepted, reaction
+	}
+	return TapUnknownObject, 0
+}
 
-	g.ObjectSeq = append(g.ObjectSeq, GameObject{
-		ID:        uuid.New().String(),
-		Type:      map[bool]string{true: "a", false: "b"}[isTypeA],
-		Timestamp: time.Now(),
-	})
+// VisibleAt reports whether the object can be tapped at now
+func (o *GameObject) VisibleAt(now time.Time) bool {
+	if now.Before(o.Timestamp) {
+		return false
+	}
+	return o.Lifetime <= 0 || !now.After(o.Timestamp.Add(o.Lifetime))
+}
 
+// Appends the next object of the game's seeded sequence. rules must be the
+// version recorded on the game.
+func (g *Game) GenerateObjectSequence(rules *Rules) error {
+	obj, err := GenerateObject(g.GeneratorVersion, g.Seed, len(g.ObjectSeq), g.StartTime, rules)
+	if err != nil {
+		return err
+	}
+	g.ObjectSeq = append(g.ObjectSeq, obj)
+	return nil
+}
+
+// SpawnObject appends the next object of the game's seeded sequence and
+// returns it, or returns ErrNoObjectsLeft when it would appear after the game
+// expires. rules must be the version recorded on the game.
+func (g *Game) SpawnObject(rules *Rules) (*GameObject, error) {
+	obj, err := GenerateObject(g.GeneratorVersion, g.Seed, len(g.ObjectSeq), g.StartTime, rules)
+	if err != nil {
+		return nil, err
+	}
+	if obj.Timestamp.After(g.ExpiresAt) {
+		return nil, ErrNoObjectsLeft
+	}
+	g.ObjectSeq = append(g.ObjectSeq, obj)
+	return &g.ObjectSeq[len(g.ObjectSeq)-1], nil
+}
+
+// Regenerates the objects spawned so far from the seed and reports whether
+// they match the stored sequence
+func (g *Game) VerifyObjectSequence(rules *Rules) (bool, error) {
+	expected, err := GenerateSequence(g.GeneratorVersion, g.Seed, len(g.ObjectSeq), g.StartTime, rules)
+	if err != nil {
+		return false, err
+	}
+	for i, obj := range g.ObjectSeq {
+		if obj.ID != expected[i].ID || obj.Type != expected[i].Type || !obj.Timestamp.Equal(expected[i].Timestamp) || obj.Points != expected[i].Points {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 func StartOfDay(t time.Time) time.Time {
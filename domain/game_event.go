@@ -0,0 +1,87 @@
+package domain
+
+import "time"
+
+// GameEventType identifies what happened in a game
+type GameEventType string
+
+const (
+	GameEventSpawn  GameEventType = "spawn"
+	GameEventTap    GameEventType = "tap"
+	GameEventEnd    GameEventType = "end"
+	GameEventExpire GameEventType = "expire"
+)
+
+// GameEvent is one entry of a game's append-only event log. Together the
+// events of a game explain how its score was reached.
+type GameEvent struct {
+	GameID string `bson:"GameID"`
+	// Position in the game's log, assigned by the repository from 1
+	Sequence   int64         `bson:"Sequence"`
+	Type       GameEventType `bson:"Type"`
+	ObjectID   string        `bson:"ObjectID,omitempty"`
+	ObjectType string        `bson:"ObjectType,omitempty"`
+	Points     int32         `bson:"Points,omitempty"`
+	TapResult  TapResult     `bson:"TapResult,omitempty"`
+	// Why a tap was refused without a verdict, e.g. the game had expired
+	Reason string `bson:"Reason,omitempty"`
+	// When the player says they tapped
+	TappedAt     time.Time     `bson:"TappedAt,omitempty"`
+	ReactionTime time.Duration `bson:"ReactionTime,omitempty"`
+	// Game score after the event
+	Score int32 `bson:"Score"`
+	// Server time the event happened
+	Timestamp time.Time `bson:"Timestamp"`
+}
+
+func NewSpawnEvent(game *Game, obj *GameObject, now time.Time) *GameEvent {
+	return &GameEvent{
+		GameID:     game.ID,
+		Type:       GameEventSpawn,
+		ObjectID:   obj.ID,
+		ObjectType: obj.Type,
+		Points:     obj.Points,
+		Score:      game.Score,
+		Timestamp:  now,
+	}
+}
+
+func NewTapEvent(game *Game, objectID string, tappedAt time.Time, outcome TapOutcome) *GameEvent {
+	return &GameEvent{
+		GameID:       game.ID,
+		Type:         GameEventTap,
+		ObjectID:     objectID,
+		TapResult:    outcome.Result,
+		TappedAt:     tappedAt,
+		ReactionTime: outcome.ReactionTime,
+		Score:        outcome.Score,
+		Timestamp:    outcome.Timestamp,
+	}
+}
+
+// NewRejectedTapEvent records a tap the game refused before judging it
+func NewRejectedTapEvent(game *Game, objectID string, tappedAt time.Time, reason error, now time.Time) *GameEvent {
+	return &GameEvent{
+		GameID:    game.ID,
+		Type:      GameEventTap,
+		ObjectID:  objectID,
+		Reason:    reason.Error(),
+		TappedAt:  tappedAt,
+		Score:     game.Score,
+		Timestamp: now,
+	}
+}
+
+// NewFinishEvent records the game ending or expiring
+func NewFinishEvent(game *Game, now time.Time) *GameEvent {
+	eventType := GameEventEnd
+	if game.Status == GameStatusExpired {
+		eventType = GameEventExpire
+	}
+	return &GameEvent{
+		GameID:    game.ID,
+		Type:      eventType,
+		Score:     game.Score,
+		Timestamp: now,
+	}
+}
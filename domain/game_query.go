@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultGamePageSize = 20
+	MaxGamePageSize     = 100
+)
+
+var ErrInvalidGameQuery = errors.New("invalid game query")
+
+// GameQuery selects one page of a user's games ordered by start time, newest
+// first unless Ascending is set
+type GameQuery struct {
+	UserID string
+	// Only games of this mode, empty matches every mode
+	Mode string
+	// Games started at or after From and before To, a zero time leaves that
+	// end of the range open
+	From      time.Time
+	To        time.Time
+	Ascending bool
+	// Page size, 0 uses DefaultGamePageSize
+	Limit int
+	// Continue after this position, nil starts at the first page
+	After *GameCursor
+}
+
+// PageSize returns the number of games the query asks for, capped at
+// MaxGamePageSize
+func (q GameQuery) PageSize() int {
+	if q.Limit <= 0 {
+		return DefaultGamePageSize
+	}
+	if q.Limit > MaxGamePageSize {
+		return MaxGamePageSize
+	}
+	return q.Limit
+}
+
+func (q GameQuery) Validate() error {
+	if q.UserID == "" {
+		return fmt.Errorf("%w: missing user", ErrInvalidGameQuery)
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return fmt.Errorf("%w: empty date range", ErrInvalidGameQuery)
+	}
+	return nil
+}
+
+// Matches reports whether game belongs on a page of the query, ignoring the
+// page size
+func (q GameQuery) Matches(game *Game) bool {
+	if game.UserID != q.UserID {
+		return false
+	}
+	if q.Mode != "" && game.Mode != q.Mode {
+		return false
+	}
+	if !q.From.IsZero() && game.StartTime.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !game.StartTime.Before(q.To) {
+		return false
+	}
+	if q.After == nil {
+		return true
+	}
+	if q.Ascending {
+		return q.After.Less(CursorOf(game))
+	}
+	return CursorOf(game).Less(*q.After)
+}
+
+// GameCursor is the position of a game in start time order. Games starting
+// in the same millisecond are ordered by ID.
+type GameCursor struct {
+	StartTime time.Time
+	ID        string
+}
+
+func CursorOf(game *Game) GameCursor {
+	return GameCursor{StartTime: game.StartTime.Truncate(time.Millisecond), ID: game.ID}
+}
+
+// Less reports whether c comes before other in ascending order
+func (c GameCursor) Less(other GameCursor) bool {
+	a, b := c.StartTime.UnixMilli(), other.StartTime.UnixMilli()
+	if a != b {
+		return a < b
+	}
+	return c.ID < other.ID
+}
+
+// Encode returns an opaque page token for the cursor
+func (c GameCursor) Encode() string {
+	raw := strconv.FormatInt(c.StartTime.UnixMilli(), 10) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeGameCursor parses a page token made by Encode
+func DecodeGameCursor(token string) (*GameCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidGameQuery)
+	}
+	millis, id, found := strings.Cut(string(raw), ":")
+	if !found || id == "" {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidGameQuery)
+	}
+	startTime, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", ErrInvalidGameQuery)
+	}
+	return &GameCursor{StartTime: time.UnixMilli(startTime).UTC(), ID: id}, nil
+}
+
+// GamePage is one page of games. Next is set when more games follow.
+type GamePage struct {
+	Games []*Game
+	Next  *GameCursor
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Versions of the object sequence generator. A game records the version it
+// was created with so its sequence can always be regenerated, even after a
+// newer generator becomes the default.
+const (
+	// 50/50 "a" or "b" objects on DefaultSpawnConfig
+	GeneratorV1 int32 = 1
+	// Weighted objects, points, lifetimes and timing from the game's rules
+	GeneratorV2 int32 = 2
+
+	CurrentGeneratorVersion = GeneratorV2
+)
+
+var ErrUnknownGeneratorVersion = errors.New("unknown object generator version")
+
+// SpawnConfig controls when generated objects appear relative to the game start
+type SpawnConfig struct {
+	Interval time.Duration
+	Jitter   time.Duration
+}
+
+var DefaultSpawnConfig = SpawnConfig{
+	Interval: 800 * time.Millisecond,
+	Jitter:   400 * time.Millisecond,
+}
+
+// NewSeed returns a server generated seed for a game
+func NewSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		// crypto/rand never fails on supported platforms, but keep games
+		// playable if it ever does.
+		return time.Now().UnixNano()
+	}
+	return int64(binary.BigEndian.Uint64(b[:]))
+}
+
+// GenerateObject returns the object at position index of the sequence
+// described by version, seed and rules. The result only depends on its
+// arguments, so any object of a game can be regenerated independently.
+// GeneratorV1 predates rules and ignores them.
+func GenerateObject(version int32, seed int64, index int, start time.Time, rules *Rules) (GameObject, error) {
+	switch version {
+	case GeneratorV1:
+		return generateObjectV1(seed, index, start, DefaultSpawnConfig)
+	case GeneratorV2:
+		if rules == nil {
+			return GameObject{}, fmt.Errorf("%w: generator %d needs rules", ErrInvalidRules, version)
+		}
+		return generateObjectV2(seed, index, start, rules)
+	default:
+		return GameObject{}, fmt.Errorf("%w: %d", ErrUnknownGeneratorVersion, version)
+	}
+}
+
+// GenerateSequence regenerates the first count objects of a sequence
+func GenerateSequence(version int32, seed int64, count int, start time.Time, rules *Rules) ([]GameObject, error) {
+	objects := make([]GameObject, 0, count)
+	for i := 0; i < count; i++ {
+		obj, err := GenerateObject(version, seed, i, start, rules)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func generateObjectV1(seed int64, index int, start time.Time, config SpawnConfig) (GameObject, error) {
+	rng := rand.New(rand.NewSource(mixSeed(seed, uint64(index))))
+
+	id, err := uuid.NewRandomFromReader(rng)
+	if err != nil {
+		return GameObject{}, err
+	}
+
+	isTypeA := rng.Intn(2) == 0
+
+	offset := time.Duration(index) * config.Interval
+	if jitter := config.Jitter.Milliseconds(); jitter > 0 {
+		// Millisecond steps survive a round trip through the store
+		offset += time.Duration(rng.Int63n(jitter)) * time.Millisecond
+	}
+
+	objectType := map[bool]string{true: "a", false: "b"}[isTypeA]
+
+	return GameObject{
+		ID:        id.String(),
+		Type:      objectType,
+		Timestamp: start.Add(offset),
+		Points:    map[bool]int32{true: 1, false: -5}[isTypeA],
+	}, nil
+}
+
+func generateObjectV2(seed int64, index int, start time.Time, rules *Rules) (GameObject, error) {
+	rng := rand.New(rand.NewSource(mixSeed(seed, uint64(index))))
+
+	id, err := uuid.NewRandomFromReader(rng)
+	if err != nil {
+		return GameObject{}, err
+	}
+
+	totalWeight := 0
+	for _, obj := range rules.Objects {
+		totalWeight += obj.Weight
+	}
+	if totalWeight <= 0 {
+		return GameObject{}, fmt.Errorf("%w: %s has no spawnable objects", ErrInvalidRules, rules.Version)
+	}
+	pick := rng.Intn(totalWeight)
+	var rule ObjectRule
+	for _, obj := range rules.Objects {
+		if pick < obj.Weight {
+			rule = obj
+			break
+		}
+		pick -= obj.Weight
+	}
+
+	config := rules.SpawnConfig()
+	offset := time.Duration(index) * config.Interval
+	if jitter := config.Jitter.Milliseconds(); jitter > 0 {
+		offset += time.Duration(rng.Int63n(jitter)) * time.Millisecond
+	}
+
+	return GameObject{
+		ID:        id.String(),
+		Type:      rule.Type,
+		Timestamp: start.Add(offset),
+		Lifetime:  rule.Lifetime(),
+		Points:    rule.Points,
+	}, nil
+}
+
+// mixSeed derives an independent stream for each object (splitmix64)
+func mixSeed(seed int64, index uint64) int64 {
+	z := uint64(seed) + (index+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
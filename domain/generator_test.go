@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSequence(t *testing.T) {
+	t.Run("sequence is a function of the seed", func(t *testing.T) {
+		game := NewGameWithSeed("1", 42, &DefaultRules, time.Minute)
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, game.GenerateObjectSequence(&DefaultRules))
+		}
+
+		replayed, err := GenerateSequence(game.GeneratorVersion, game.Seed, len(game.ObjectSeq), game.StartTime, &DefaultRules)
+		assert.NoError(t, err)
+		assert.Equal(t, game.ObjectSeq, replayed)
+
+		valid, err := game.VerifyObjectSequence(&DefaultRules)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("a changed object fails verification", func(t *testing.T) {
+		game := NewGameWithSeed("1", 42, &DefaultRules, time.Minute)
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, game.GenerateObjectSequence(&DefaultRules))
+		}
+		game.ObjectSeq[3].Points += 10
+
+		valid, err := game.VerifyObjectSequence(&DefaultRules)
+
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("different seeds produce different sequences", func(t *testing.T) {
+		a, err := GenerateSequence(CurrentGeneratorVersion, 1, 10, time.Unix(0, 0), &DefaultRules)
+		assert.NoError(t, err)
+		b, err := GenerateSequence(CurrentGeneratorVersion, 2, 10, time.Unix(0, 0), &DefaultRules)
+		assert.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("unknown generator version", func(t *testing.T) {
+		_, err := GenerateSequence(99, 1, 10, time.Unix(0, 0), &DefaultRules)
+
+		assert.ErrorIs(t, err, ErrUnknownGeneratorVersion)
+	})
+}
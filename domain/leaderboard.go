@@ -10,6 +10,8 @@ type GameEntry struct {
 	User      User      `bson:"User"`
 	Score     int32     `bson:"Score"`
 	Timestamp time.Time `bson:"Timestamp"`
+	// Game the score was made in, a board holds at most one entry per game
+	GameID string `bson:"GameID,omitempty"`
 }
 
 type Table struct {
@@ -39,6 +41,19 @@ func NewLeaderboardObject(user User, score int32) *GameEntry {
 	return entry
 }
 
+// HasGame reports whether the board already holds the score of gameID
+func (t *Table) HasGame(gameID string) bool {
+	if gameID == "" {
+		return false
+	}
+	for _, entry := range t.Entries {
+		if entry.GameID == gameID {
+			return true
+		}
+	}
+	return false
+}
+
 func OrderLeaderboard(table *Table) *Table {
 	// Sort table by Score and Timestamp using slices.SortFunc.
 	// The newest score is further up the leaderboard.
@@ -84,13 +99,42 @@ func getUserDisplayName(user User) string {
 	return user.LastName
 }
 
-func GroupAndTotalScoresByUserSorted(table *Table) []UserScore {
+// Quarantine holds the IDs of the users whose scores only they can see
+type Quarantine map[int64]bool
+
+// NewQuarantine quarantines the moderated users
+func NewQuarantine(users []*User) Quarantine {
+	quarantine := make(Quarantine, len(users))
+	for _, user := range users {
+		if user.IsModerated() {
+			quarantine[user.UserId] = true
+		}
+	}
+	return quarantine
+}
+
+// Hides reports whether viewer, nil for an anonymous viewer, must not see the
+// scores of user
+func (q Quarantine) Hides(user User, viewer *User) bool {
+	if !q[user.UserId] {
+		return false
+	}
+	return viewer == nil || viewer.UserId != user.UserId
+}
+
+// GroupAndTotalScoresByUserSorted totals the board's scores by user as viewer
+// sees them: quarantined users are left out unless they are the viewer, so
+// they still see their own score and rank
+func GroupAndTotalScoresByUserSorted(table *Table, quarantine Quarantine, viewer *User) []UserScore {
 	// Create maps to store totals and track last played time
 	userTotals := make(map[string]int32)
 	userDetails := make(map[string]User)
 
 	// Calculate totals and track most recent timestamp for each user
 	for _, entry := range table.Entries {
+		if quarantine.Hides(entry.User, viewer) {
+			continue
+		}
 		displayName := getUserDisplayName(entry.User)
 		userTotals[displayName] += entry.Score
 		userDetails[displayName] = entry.User
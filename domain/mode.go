@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Game modes known to DefaultGameModes
+const (
+	GameModeTimed    = DefaultGameMode
+	GameModeEndless  = "endless"
+	GameModePractice = "practice"
+	GameModeDaily    = "daily"
+)
+
+// EndlessGameDuration caps an endless game so abandoned games still expire
+const EndlessGameDuration = 30 * time.Minute
+
+var (
+	ErrUnknownGameMode      = errors.New("unknown game mode")
+	ErrInvalidGameMode      = errors.New("invalid game mode")
+	ErrDailyChallengePlayed = errors.New("daily challenge already played today")
+)
+
+// GameMode describes how a kind of game is played and where it is scored
+type GameMode struct {
+	Name string
+	// How long a game can run
+	Duration time.Duration
+	// Mode of the rules in the RuleBook games are played under
+	Rules string
+	// Plays a game uses up from the player's allowance
+	AllowanceCost int
+	// Board scores are published to, empty keeps scores off leaderboards
+	Leaderboard string
+	// Mistakes that end the game, 0 allows any number
+	MaxMistakes int32
+	// Games are flagged as practice so stats can leave them out
+	Practice bool
+	// Every player gets the same sequence each UTC day, one attempt a day,
+	// and scores go to a board per day prefixed with Leaderboard
+	Daily bool
+}
+
+func (m *GameMode) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("%w: missing name", ErrInvalidGameMode)
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("%w: %s has no duration", ErrInvalidGameMode, m.Name)
+	}
+	if m.Rules == "" {
+		return fmt.Errorf("%w: %s has no rules", ErrInvalidGameMode, m.Name)
+	}
+	if m.AllowanceCost < 0 || m.MaxMistakes < 0 {
+		return fmt.Errorf("%w: %s has a negative allowance cost or mistake limit", ErrInvalidGameMode, m.Name)
+	}
+	if m.Practice && (m.AllowanceCost > 0 || m.Leaderboard != "") {
+		return fmt.Errorf("%w: practice mode %s cannot use plays or publish scores", ErrInvalidGameMode, m.Name)
+	}
+	return nil
+}
+
+// DefaultGameModes returns the built in modes, with timed games lasting
+// timedDuration
+func DefaultGameModes(timedDuration time.Duration) []GameMode {
+	return []GameMode{
+		{Name: GameModeTimed, Duration: timedDuration, Rules: DefaultGameMode, AllowanceCost: 1, Leaderboard: "qiba"},
+		{Name: GameModeEndless, Duration: EndlessGameDuration, Rules: GameModeEndless, AllowanceCost: 1, Leaderboard: "qiba-endless", MaxMistakes: 3},
+		{Name: GameModePractice, Duration: timedDuration, Rules: DefaultGameMode, Practice: true},
+		{Name: GameModeDaily, Duration: timedDuration, Rules: DefaultGameMode, Leaderboard: "daily", Daily: true},
+	}
+}
+
+// Day returns the UTC calendar day t falls on
+func Day(t time.Time) time.Time {
+	return StartOfDay(t.UTC())
+}
+
+// LeaderboardFor returns the board a game of the mode started at startTime
+// publishes to
+func (m *GameMode) LeaderboardFor(startTime time.Time) string {
+	if m.Daily && m.Leaderboard != "" {
+		return m.Leaderboard + "-" + Day(startTime).Format(time.DateOnly)
+	}
+	return m.Leaderboard
+}
+
+// DailySeed returns the seed shared by every daily challenge played on day.
+// salt keeps upcoming sequences from being computed ahead of time.
+func DailySeed(salt string, day time.Time) int64 {
+	sum := sha256.Sum256([]byte(salt + "|" + Day(day).Format(time.DateOnly)))
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
+
+// ModeRegistry holds the game modes players can choose from
+type ModeRegistry struct {
+	modes map[string]*GameMode
+	names []string
+}
+
+// NewModeRegistry checks every mode has rules in book
+func NewModeRegistry(modes []GameMode, book *RuleBook) (*ModeRegistry, error) {
+	registry := &ModeRegistry{modes: make(map[string]*GameMode)}
+	for i := range modes {
+		mode := modes[i]
+		if err := mode.Validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := registry.modes[mode.Name]; exists {
+			return nil, fmt.Errorf("%w: duplicate mode %s", ErrInvalidGameMode, mode.Name)
+		}
+		if _, err := book.ForMode(mode.Rules); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidGameMode, mode.Name, err)
+		}
+		registry.modes[mode.Name] = &mode
+		registry.names = append(registry.names, mode.Name)
+	}
+	return registry, nil
+}
+
+// Mode returns the named mode, an empty name is DefaultGameMode
+func (r *ModeRegistry) Mode(name string) (*GameMode, error) {
+	if name == "" {
+		name = DefaultGameMode
+	}
+	mode, exists := r.modes[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownGameMode, name)
+	}
+	return mode, nil
+}
+
+// Modes returns every mode in the order they were registered
+func (r *ModeRegistry) Modes() []*GameMode {
+	modes := make([]*GameMode, 0, len(r.names))
+	for _, name := range r.names {
+		modes = append(modes, r.modes[name])
+	}
+	return modes
+}
+
+// Leaderboards returns the boards the modes publish to. Daily boards are
+// created as each day is played.
+func (r *ModeRegistry) Leaderboards() []string {
+	var boards []string
+	for _, mode := range r.Modes() {
+		if mode.Leaderboard != "" && !mode.Daily && !slices.Contains(boards, mode.Leaderboard) {
+			boards = append(boards, mode.Leaderboard)
+		}
+	}
+	return boards
+}
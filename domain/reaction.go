@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Thresholds used to flag games whose reaction times look automated
+const (
+	// Reaction statistics need a few taps before they mean anything
+	MinReactionSamples = 5
+	// Median reaction time below this is faster than people manage to keep up
+	SuspiciousMedianReaction = 180 * time.Millisecond
+	// Human reaction times vary, a near constant reaction time suggests a bot
+	SuspiciousReactionStdDev = 15 * time.Millisecond
+)
+
+// ReactionStats summarise the reaction times of a game's scored taps
+type ReactionStats struct {
+	Count      int           `bson:"Count"`
+	Min        time.Duration `bson:"Min"`
+	Median     time.Duration `bson:"Median"`
+	Variance   float64       `bson:"Variance"` // squared milliseconds
+	Suspicious bool          `bson:"Suspicious"`
+}
+
+// NewReactionStats computes the statistics for reaction times
+func NewReactionStats(reactions []time.Duration) ReactionStats {
+	stats := ReactionStats{Count: len(reactions)}
+	if len(reactions) == 0 {
+		return stats
+	}
+
+	sorted := slices.Clone(reactions)
+	slices.Sort(sorted)
+	stats.Min = sorted[0]
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		stats.Median = (sorted[middle-1] + sorted[middle]) / 2
+	} else {
+		stats.Median = sorted[middle]
+	}
+
+	var mean float64
+	for _, r := range sorted {
+		mean += float64(r.Milliseconds())
+	}
+	mean /= float64(len(sorted))
+	for _, r := range sorted {
+		d := float64(r.Milliseconds()) - mean
+		stats.Variance += d * d
+	}
+	stats.Variance /= float64(len(sorted))
+
+	if stats.Count >= MinReactionSamples {
+		stdDev := SuspiciousReactionStdDev.Milliseconds()
+		stats.Suspicious = stats.Median < SuspiciousMedianReaction || stats.Variance < float64(stdDev*stdDev)
+	}
+	return stats
+}
+
+// updateReactionStats recomputes the game's statistics from its scored taps
+func (g *Game) updateReactionStats() {
+	var reactions []time.Duration
+	for _, obj := range g.ObjectSeq {
+		if obj.Tapped {
+			reactions = append(reactions, obj.ReactionTime)
+		}
+	}
+	g.Reaction = NewReactionStats(reactions)
+}
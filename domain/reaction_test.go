@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionStats(t *testing.T) {
+	ms := func(values ...int) []time.Duration {
+		durations := make([]time.Duration, 0, len(values))
+		for _, v := range values {
+			durations = append(durations, time.Duration(v)*time.Millisecond)
+		}
+		return durations
+	}
+
+	human := NewReactionStats(ms(320, 250, 410, 290, 510, 275))
+	assert.Equal(t, 6, human.Count)
+	assert.Equal(t, 250*time.Millisecond, human.Min)
+	assert.Equal(t, 305*time.Millisecond, human.Median)
+	assert.Greater(t, human.Variance, 5000.0)
+	assert.False(t, human.Suspicious)
+
+	robotic := NewReactionStats(ms(240, 242, 241, 240, 243))
+	assert.Equal(t, 241*time.Millisecond, robotic.Median)
+	assert.True(t, robotic.Suspicious)
+
+	tooQuick := NewReactionStats(ms(120, 160, 110, 190, 140))
+	assert.True(t, tooQuick.Suspicious)
+
+	tooFew := NewReactionStats(ms(120, 121))
+	assert.False(t, tooFew.Suspicious)
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// VerificationStatus is the outcome of replaying a game's event log
+type VerificationStatus string
+
+const (
+	// The replayed score matches the game's score
+	VerificationVerified VerificationStatus = "verified"
+	// The replay disagrees with the game, or the game could not be replayed
+	VerificationMismatch VerificationStatus = "mismatch"
+)
+
+var (
+	ErrReplayMismatch  = errors.New("replay does not match the game")
+	ErrScoreUnverified = errors.New("score has not been verified")
+)
+
+// ScoreVerification records how a game's score compared with a replay of its
+// event log. Only verified scores are published.
+type ScoreVerification struct {
+	Status      VerificationStatus `bson:"Status"`
+	ReplayScore int32              `bson:"ReplayScore"`
+	// Why the replay disagreed
+	Detail string `bson:"Detail,omitempty"`
+}
+
+func (v ScoreVerification) Verified() bool {
+	return v.Status == VerificationVerified
+}
+
+// Replay re-simulates a game from its seed and the spawns and taps in events,
+// scoring every tap again under rules. It returns the replayed game, or
+// ErrReplayMismatch when a spawned object or tap verdict differs from the log.
+func Replay(game *Game, events []*GameEvent, rules *Rules) (*Game, error) {
+	replay := &Game{
+		ID:               game.ID,
+		StartTime:        game.StartTime,
+		ExpiresAt:        game.ExpiresAt,
+		Status:           GameStatusRunning,
+		UserID:           game.UserID,
+		Seed:             game.Seed,
+		GeneratorVersion: game.GeneratorVersion,
+		Mode:             game.Mode,
+		RulesVersion:     game.RulesVersion,
+	}
+	for _, event := range events {
+		switch event.Type {
+		case GameEventSpawn:
+			if err := replay.GenerateObjectSequence(rules); err != nil {
+				return nil, err
+			}
+			if obj := replay.ObjectSeq[len(replay.ObjectSeq)-1]; obj.ID != event.ObjectID {
+				return nil, fmt.Errorf("%w: event %d spawned %s, expected %s", ErrReplayMismatch, event.Sequence, event.ObjectID, obj.ID)
+			}
+		case GameEventTap:
+			// Taps refused before they were judged never changed the game
+			if event.TapResult == "" {
+				continue
+			}
+			outcome := replay.Tap(event.ObjectID, event.TappedAt, event.Timestamp, rules)
+			if outcome.Result != event.TapResult || outcome.Score != event.Score {
+				return nil, fmt.Errorf("%w: event %d was %s scoring %d, replayed as %s scoring %d", ErrReplayMismatch, event.Sequence, event.TapResult, event.Score, outcome.Result, outcome.Score)
+			}
+		}
+	}
+	return replay, nil
+}
+
+// Verify replays the game's event log and records whether the replayed score
+// matches the score kept while the game was played
+func (g *Game) Verify(events []*GameEvent, rules *Rules) ScoreVerification {
+	replay, err := Replay(g, events, rules)
+	switch {
+	case err != nil:
+		g.Verification = ScoreVerification{Status: VerificationMismatch, Detail: err.Error()}
+	case replay.Score != g.Score:
+		g.Verification = ScoreVerification{
+			Status:      VerificationMismatch,
+			ReplayScore: replay.Score,
+			Detail:      fmt.Sprintf("%s: replayed score %d, game score %d", ErrReplayMismatch, replay.Score, g.Score),
+		}
+	default:
+		g.Verification = ScoreVerification{Status: VerificationVerified, ReplayScore: replay.Score}
+	}
+	return g.Verification
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	// play spawns and taps count objects of a game and returns its event log
+	play := func(t *testing.T, count int) (*Game, []*GameEvent) {
+		game := NewGameWithSeed("1", 7, &DefaultRules, time.Minute)
+		events := make([]*GameEvent, 0, 2*count)
+		for i := 0; i < count; i++ {
+			assert.NoError(t, game.GenerateObjectSequence(&DefaultRules))
+			obj := game.ObjectSeq[len(game.ObjectSeq)-1]
+			events = append(events, NewSpawnEvent(game, &obj, obj.Timestamp))
+
+			tappedAt := obj.Timestamp.Add(300 * time.Millisecond)
+			outcome := game.Tap(obj.ID, tappedAt, tappedAt, &DefaultRules)
+			events = append(events, NewTapEvent(game, obj.ID, tappedAt, outcome))
+		}
+		for i, event := range events {
+			event.Sequence = int64(i + 1)
+		}
+		return game, events
+	}
+
+	t.Run("replay matches", func(t *testing.T) {
+		game, events := play(t, 5)
+
+		verification := game.Verify(events, &DefaultRules)
+
+		assert.True(t, verification.Verified(), verification.Detail)
+		assert.Equal(t, game.Score, verification.ReplayScore)
+		assert.Equal(t, verification, game.Verification)
+	})
+
+	t.Run("a changed score is a mismatch", func(t *testing.T) {
+		game, events := play(t, 5)
+		replayed := game.Score
+		game.Score += 10
+
+		verification := game.Verify(events, &DefaultRules)
+
+		assert.Equal(t, VerificationMismatch, verification.Status)
+		assert.Equal(t, replayed, verification.ReplayScore)
+		assert.Contains(t, verification.Detail, ErrReplayMismatch.Error())
+	})
+
+	t.Run("a spawn the seed never produced is a mismatch", func(t *testing.T) {
+		game, events := play(t, 5)
+		events[4].ObjectID = "forged"
+
+		verification := game.Verify(events, &DefaultRules)
+
+		assert.Equal(t, VerificationMismatch, verification.Status)
+		assert.Contains(t, verification.Detail, "spawned forged")
+	})
+
+	t.Run("a changed tap verdict is a mismatch", func(t *testing.T) {
+		game, events := play(t, 5)
+		events[3].TapResult = TapMiss
+
+		_, err := Replay(game, events, &DefaultRules)
+
+		assert.ErrorIs(t, err, ErrReplayMismatch)
+	})
+
+	t.Run("refused taps are skipped", func(t *testing.T) {
+		game, events := play(t, 3)
+		refused := NewRejectedTapEvent(game, game.ObjectSeq[0].ID, time.Now(), ErrGameExpired, time.Now())
+		events = append(events, refused)
+
+		verification := game.Verify(events, &DefaultRules)
+
+		assert.True(t, verification.Verified(), verification.Detail)
+	})
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReviewStatus is where a held game is in moderation
+type ReviewStatus string
+
+const (
+	// The game waits for a moderator, its score is not published
+	ReviewPending ReviewStatus = "pending"
+	// A moderator found the game fair and its score was published
+	ReviewApproved ReviewStatus = "approved"
+	// A moderator found the game unfair, its score stays unpublished
+	ReviewRejected ReviewStatus = "rejected"
+)
+
+var (
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrReviewDecided       = errors.New("review already decided")
+	ErrUnknownReviewStatus = errors.New("unknown review status")
+)
+
+// ParseReviewStatus returns the review status named s
+func ParseReviewStatus(s string) (ReviewStatus, error) {
+	switch status := ReviewStatus(s); status {
+	case ReviewPending, ReviewApproved, ReviewRejected:
+		return status, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownReviewStatus, s)
+}
+
+// GameReview holds a game whose score the anti-cheat analyzer found too risky
+// to publish without a moderator looking at it
+type GameReview struct {
+	GameID string `bson:"GameID"`
+	UserID string `bson:"UserID"`
+	Mode   string `bson:"Mode"`
+	Score  int32  `bson:"Score"`
+	// Board the score goes to once it is approved
+	Leaderboard string         `bson:"Leaderboard"`
+	Risk        RiskAssessment `bson:"Risk"`
+	Status      ReviewStatus   `bson:"Status"`
+	CreatedAt   time.Time      `bson:"CreatedAt"`
+	// The moderator who approved or rejected the game, and when
+	ReviewedBy string    `bson:"ReviewedBy,omitempty"`
+	ReviewedAt time.Time `bson:"ReviewedAt,omitempty"`
+}
+
+func NewGameReview(game *Game, leaderboard string, risk RiskAssessment, now time.Time) *GameReview {
+	return &GameReview{
+		GameID:      game.ID,
+		UserID:      game.UserID,
+		Mode:        game.Mode,
+		Score:       game.Score,
+		Leaderboard: leaderboard,
+		Risk:        risk,
+		Status:      ReviewPending,
+		CreatedAt:   now,
+	}
+}
+
+// Decide records a moderator's verdict on a pending review
+func (r *GameReview) Decide(status ReviewStatus, moderatorId string, now time.Time) error {
+	if r.Status != ReviewPending {
+		return fmt.Errorf("%w: game %s was %s", ErrReviewDecided, r.GameID, r.Status)
+	}
+	if status != ReviewApproved && status != ReviewRejected {
+		return fmt.Errorf("%w: %q is not a verdict", ErrUnknownReviewStatus, status)
+	}
+	r.Status = status
+	r.ReviewedBy = moderatorId
+	r.ReviewedAt = now
+	return nil
+}
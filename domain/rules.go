@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultGameMode is the mode games are played in unless another is requested
+const DefaultGameMode = "timed"
+
+var (
+	ErrUnknownRulesVersion = errors.New("unknown rules version")
+	ErrNoRulesForMode      = errors.New("no rules for game mode")
+	ErrInvalidRules        = errors.New("invalid rules")
+)
+
+// ObjectRule describes one type of object in the catalogue
+type ObjectRule struct {
+	Type string `json:"type"`
+	// Relative chance of the object being spawned
+	Weight int `json:"weight"`
+	// Score change when the object is tapped
+	Points int32 `json:"points"`
+	// How long the object stays visible, 0 keeps it until tapped
+	LifetimeMs int64 `json:"lifetime_ms"`
+}
+
+func (o ObjectRule) Lifetime() time.Duration {
+	return time.Duration(o.LifetimeMs) * time.Millisecond
+}
+
+// Rules is a versioned object catalogue and scoring scheme for a game mode.
+// Games record the version they were played under, so a published version
+// must never be edited; add a new version instead.
+type Rules struct {
+	Version         string `json:"version"`
+	Mode            string `json:"mode"`
+	SpawnIntervalMs int64  `json:"spawn_interval_ms"`
+	SpawnJitterMs   int64  `json:"spawn_jitter_ms"`
+	// Taps arriving sooner than this after an object spawned are rejected
+	MinReactionMs int64        `json:"min_reaction_ms"`
+	Objects       []ObjectRule `json:"objects"`
+}
+
+func (r *Rules) SpawnConfig() SpawnConfig {
+	return SpawnConfig{
+		Interval: time.Duration(r.SpawnIntervalMs) * time.Millisecond,
+		Jitter:   time.Duration(r.SpawnJitterMs) * time.Millisecond,
+	}
+}
+
+func (r *Rules) MinReaction() time.Duration {
+	return time.Duration(r.MinReactionMs) * time.Millisecond
+}
+
+// Object returns the rule for objectType
+func (r *Rules) Object(objectType string) (ObjectRule, bool) {
+	for _, obj := range r.Objects {
+		if obj.Type == objectType {
+			return obj, true
+		}
+	}
+	return ObjectRule{}, false
+}
+
+func (r *Rules) Validate() error {
+	if r.Version == "" {
+		return fmt.Errorf("%w: missing version", ErrInvalidRules)
+	}
+	if r.Mode == "" {
+		return fmt.Errorf("%w: %s has no mode", ErrInvalidRules, r.Version)
+	}
+	if r.SpawnIntervalMs <= 0 || r.SpawnJitterMs < 0 {
+		return fmt.Errorf("%w: %s has an invalid spawn interval", ErrInvalidRules, r.Version)
+	}
+	if r.MinReactionMs < 0 {
+		return fmt.Errorf("%w: %s has an invalid minimum reaction time", ErrInvalidRules, r.Version)
+	}
+	if len(r.Objects) == 0 {
+		return fmt.Errorf("%w: %s has no objects", ErrInvalidRules, r.Version)
+	}
+	types := make(map[string]bool)
+	for _, obj := range r.Objects {
+		if obj.Type == "" || types[obj.Type] {
+			return fmt.Errorf("%w: %s has a missing or duplicate object type %q", ErrInvalidRules, r.Version, obj.Type)
+		}
+		if obj.Weight <= 0 || obj.LifetimeMs < 0 {
+			return fmt.Errorf("%w: %s object %q has an invalid weight or lifetime", ErrInvalidRules, r.Version, obj.Type)
+		}
+		types[obj.Type] = true
+	}
+	return nil
+}
+
+// DefaultRules reproduce the original hard coded game: "a" scores +1, "b"
+// costs 5 and both are equally likely.
+var DefaultRules = Rules{
+	Version:         "classic-1",
+	Mode:            DefaultGameMode,
+	SpawnIntervalMs: 800,
+	SpawnJitterMs:   400,
+	MinReactionMs:   100,
+	Objects: []ObjectRule{
+		{Type: "a", Weight: 1, Points: 1},
+		{Type: "b", Weight: 1, Points: -5},
+	},
+}
+
+// DefaultEndlessRules play the classic catalogue, but objects disappear so
+// slow taps miss
+var DefaultEndlessRules = Rules{
+	Version:         "endless-1",
+	Mode:            GameModeEndless,
+	SpawnIntervalMs: 800,
+	SpawnJitterMs:   400,
+	MinReactionMs:   100,
+	Objects: []ObjectRule{
+		{Type: "a", Weight: 1, Points: 1, LifetimeMs: 2000},
+		{Type: "b", Weight: 1, Points: -5, LifetimeMs: 2000},
+	},
+}
+
+// RuleBook holds every known rules version and the active version per mode
+type RuleBook struct {
+	versions map[string]*Rules
+	modes    map[string]*Rules
+}
+
+// NewRuleBook indexes rules by version. When several versions target the
+// same mode, the last one listed is active for new games and the others are
+// kept so older games stay interpretable.
+func NewRuleBook(rules []Rules) (*RuleBook, error) {
+	book := &RuleBook{
+		versions: make(map[string]*Rules),
+		modes:    make(map[string]*Rules),
+	}
+	for i := range rules {
+		r := rules[i]
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := book.versions[r.Version]; exists {
+			return nil, fmt.Errorf("%w: duplicate version %s", ErrInvalidRules, r.Version)
+		}
+		book.versions[r.Version] = &r
+		book.modes[r.Mode] = &r
+	}
+	return book, nil
+}
+
+// DefaultRuleBook only knows the built in rules
+func DefaultRuleBook() *RuleBook {
+	book, _ := NewRuleBook([]Rules{DefaultRules, DefaultEndlessRules})
+	return book
+}
+
+// Version returns the rules a game was played under
+func (b *RuleBook) Version(version string) (*Rules, error) {
+	rules, exists := b.versions[version]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRulesVersion, version)
+	}
+	return rules, nil
+}
+
+// ForMode returns the rules new games of mode are played under
+func (b *RuleBook) ForMode(mode string) (*Rules, error) {
+	rules, exists := b.modes[mode]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrNoRulesForMode, mode)
+	}
+	return rules, nil
+}
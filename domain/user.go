@@ -1,6 +1,48 @@
 package domain
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DefaultReplayDelay is how long after a game ends the next free play is
+// available when no delay is configured
+const DefaultReplayDelay = time.Hour
+
+var (
+	ErrNoPlaysLeft             = errors.New("no plays left")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrUserBanned              = errors.New("user is banned")
+	ErrNotModerator            = errors.New("user is not a moderator")
+	ErrUnknownModerationStatus = errors.New("unknown moderation status")
+)
+
+// ModerationStatus is the standing of a user's account
+type ModerationStatus string
+
+const (
+	ModerationNormal ModerationStatus = "normal"
+	// The user's scores are hidden from every leaderboard but their own view
+	// of it, they are not told
+	ModerationQuarantined ModerationStatus = "quarantined"
+	// The user can't start games
+	ModerationBanned ModerationStatus = "banned"
+)
+
+// ParseModerationStatus returns the status named s, empty is normal
+func ParseModerationStatus(s string) (ModerationStatus, error) {
+	switch status := ModerationStatus(s); status {
+	case "", ModerationNormal:
+		return ModerationNormal, nil
+	case ModerationQuarantined, ModerationBanned:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownModerationStatus, s)
+	}
+}
 
 // type User struct {
 // 	UserId       int64
@@ -21,6 +63,20 @@ type User struct {
 	LanguageCode string             `bson:"LanguageCode"`
 	Username     string             `bson:"Username"`
 	LastName     string             `bson:"lastName"`
+	// When the user last used a free play
+	LastPlayAt time.Time `bson:"LastPlayAt"`
+	// Empty for users that were never moderated
+	Moderation ModerationStatus `bson:"Moderation,omitempty"`
+}
+
+// IsBanned reports whether the user may no longer start games
+func (u *User) IsBanned() bool {
+	return u.Moderation == ModerationBanned
+}
+
+// IsModerated reports whether the user's scores are hidden from other players
+func (u *User) IsModerated() bool {
+	return u.Moderation == ModerationQuarantined || u.Moderation == ModerationBanned
 }
 
 // Generate a new game with random object sequence
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PublicMethods can be called without a session
+var PublicMethods = []string{
+	proto.TelegramMiniApp_InitData_FullMethodName,
+	proto.GameService_Leaderboard_FullMethodName,
+	proto.GameService_GameTime_FullMethodName,
+	proto.GameService_ReceiptKeys_FullMethodName,
+}
+
+// AuthInterceptor checks the session token in the "authorization: Bearer
+// <token>" metadata of each call and puts the session into the call's
+// context. Calls without a token are refused unless the method is public or
+// sessions are not required.
+type AuthInterceptor struct {
+	issuer   *auth.Issuer
+	required bool
+	public   map[string]bool
+}
+
+func NewAuthInterceptor(issuer *auth.Issuer, required bool, publicMethods ...string) *AuthInterceptor {
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+	return &AuthInterceptor{issuer: issuer, required: required, public: public}
+}
+
+func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		if a.required && !a.public[method] {
+			return nil, status.Error(codes.Unauthenticated, "session token required")
+		}
+		return ctx, nil
+	}
+	session, err := a.issuer.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return auth.NewContext(ctx, session), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// authenticatedStream is a server stream whose context carries the session
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestIssuer(t *testing.T) *auth.Issuer {
+	issuer, err := auth.NewIssuer([]byte("0123456789abcdef0123456789abcdef"), auth.DefaultSessionTTL)
+	assert.NoError(t, err)
+	return issuer
+}
+
+// withToken is an incoming call carrying token as its bearer token
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	issuer := newTestIssuer(t)
+	token, _, err := issuer.Issue(auth.Session{UserID: 42})
+	assert.NoError(t, err)
+	// call returns the session the handler saw
+	call := func(interceptor *AuthInterceptor, ctx context.Context, method string) (*auth.Session, error) {
+		var session *auth.Session
+		_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			session, _ = auth.FromContext(ctx)
+			return nil, nil
+		})
+		return session, err
+	}
+	private := proto.GameService_StartGame_FullMethodName
+
+	t.Run("a valid token puts the session in the context", func(t *testing.T) {
+		for _, required := range []bool{true, false} {
+			interceptor := NewAuthInterceptor(issuer, required, PublicMethods...)
+
+			session, err := call(interceptor, withToken(token), private)
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, session) {
+				assert.Equal(t, int64(42), session.UserID)
+			}
+		}
+	})
+
+	t.Run("a call without a token is refused when sessions are required", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+
+		_, err := call(interceptor, context.Background(), private)
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("public methods need no token", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+
+		for _, method := range PublicMethods {
+			session, err := call(interceptor, context.Background(), method)
+			assert.NoError(t, err, method)
+			assert.Nil(t, session, method)
+		}
+	})
+
+	t.Run("a call without a token goes through when sessions are not required", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(issuer, false, PublicMethods...)
+
+		session, err := call(interceptor, context.Background(), private)
+
+		assert.NoError(t, err)
+		assert.Nil(t, session)
+	})
+
+	t.Run("a bad token is refused even on public methods", func(t *testing.T) {
+		for _, required := range []bool{true, false} {
+			interceptor := NewAuthInterceptor(issuer, required, PublicMethods...)
+
+			_, err := call(interceptor, withToken(token+"x"), proto.GameService_Leaderboard_FullMethodName)
+
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+	})
+
+	t.Run("a token from another issuer is refused", func(t *testing.T) {
+		other, err := auth.NewIssuer([]byte("fedcba9876543210fedcba9876543210"), auth.DefaultSessionTTL)
+		assert.NoError(t, err)
+		forged, _, err := other.Issue(auth.Session{UserID: 42})
+		assert.NoError(t, err)
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+
+		_, err = call(interceptor, withToken(forged), private)
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	issuer := newTestIssuer(t)
+	token, _, err := issuer.Issue(auth.Session{UserID: 42})
+	assert.NoError(t, err)
+	info := &grpc.StreamServerInfo{FullMethod: proto.GameService_PlaySession_FullMethodName}
+
+	t.Run("the handler's stream carries the session", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+		var session *auth.Session
+
+		err := interceptor.Stream()(nil, &fakeServerStream{ctx: withToken(token)}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			session, _ = auth.FromContext(stream.Context())
+			return nil
+		})
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, session) {
+			assert.Equal(t, int64(42), session.UserID)
+		}
+	})
+
+	t.Run("a stream without a token is refused when sessions are required", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+		called := false
+
+		err := interceptor.Stream()(nil, &fakeServerStream{ctx: context.Background()}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, called)
+	})
+}
@@ -8,29 +8,34 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
+	"strings"
+
+	"github.com/bernardbaker/qiba.core/ports"
 )
 
+// Encrypter seals game data with the keys of a keyring. The encrypted data
+// is an envelope of the form "<key id>.<base64 nonce and ciphertext>" so it
+// can be opened after the current key has been rotated.
 type Encrypter struct {
-	key []byte
+	keys *Keyring
 }
 
-func NewEncrypter(key []byte) *Encrypter {
-	return &Encrypter{key: key}
+func NewEncrypter(keys *Keyring) *Encrypter {
+	return &Encrypter{keys: keys}
 }
 
+// EncryptGameData encrypts data as JSON with AES-GCM under the current key.
+// It returns the envelope and a base64 encoded HMAC of it.
 func (e *Encrypter) EncryptGameData(data interface{}) (string, string, error) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return "", "", err
 	}
 
-	// Initialize cipher block and GCM
-	block, err := aes.NewCipher(e.key)
-	if err != nil {
-		return "", "", err
-	}
-	gcm, err := cipher.NewGCM(block)
+	key := e.keys.Current()
+	gcm, err := newGCM(key)
 	if err != nil {
 		return "", "", err
 	}
@@ -40,15 +45,80 @@ func (e *Encrypter) EncryptGameData(data interface{}) (string, string, error) {
 		return "", "", err
 	}
 
-	// Encrypt the JSON data and prefix the nonce
-	ciphertext := gcm.Seal(nonce, nonce, jsonData, nil)
-	// encryptedData := base64.StdEncoding.EncodeToString(ciphertext)
+	// Encrypt the JSON data bound to the key ID and prefix the nonce
+	ciphertext := gcm.Seal(nonce, nonce, jsonData, []byte(key.ID))
+	encryptedData := key.ID + "." + base64.StdEncoding.EncodeToString(ciphertext)
+
+	// Generate HMAC on the whole envelope
+	hmacValue := base64.StdEncoding.EncodeToString(sign(key, encryptedData))
+
+	return encryptedData, hmacValue, nil
+}
+
+// VerifyGameData checks hmacValue was made for encryptedData by
+// EncryptGameData with a key that is still in the keyring
+func (e *Encrypter) VerifyGameData(encryptedData, hmacValue string) error {
+	_, _, err := e.verify(encryptedData, hmacValue)
+	return err
+}
+
+// DecryptGameData verifies and decrypts data made by EncryptGameData into v
+func (e *Encrypter) DecryptGameData(encryptedData, hmacValue string, v interface{}) error {
+	key, ciphertext, err := e.verify(encryptedData, hmacValue)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return fmt.Errorf("%w: ciphertext too short", ports.ErrGameDataTampered)
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	jsonData, err := gcm.Open(nil, nonce, sealed, []byte(key.ID))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ports.ErrGameDataTampered, err)
+	}
+	return json.Unmarshal(jsonData, v)
+}
+
+// verify splits the envelope, finds its key and checks its HMAC
+func (e *Encrypter) verify(encryptedData, hmacValue string) (*Key, []byte, error) {
+	keyID, payload, ok := strings.Cut(encryptedData, ".")
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: malformed data", ports.ErrGameDataTampered)
+	}
+	key, err := e.keys.Key(keyID)
+	if err != nil {
+		// The key was retired or never existed
+		return nil, nil, fmt.Errorf("%w: %v", ports.ErrGameDataTampered, err)
+	}
+	mac, err := base64.StdEncoding.DecodeString(hmacValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed hmac", ports.ErrGameDataTampered)
+	}
+	if !hmac.Equal(mac, sign(key, encryptedData)) {
+		return nil, nil, fmt.Errorf("%w: hmac mismatch", ports.ErrGameDataTampered)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed data", ports.ErrGameDataTampered)
+	}
+	return key, ciphertext, nil
+}
 
-	// Generate HMAC on the ciphertext (including nonce)
-	mac := hmac.New(sha256.New, e.key)
-	mac.Write(ciphertext)
-	hmacValue := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+func newGCM(key *Key) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key.EncKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
 
-	// return encryptedData, hmacValue, nil
-	return string(jsonData), hmacValue, nil
+func sign(key *Key, envelope string) []byte {
+	mac := hmac.New(sha256.New, key.MacKey)
+	mac.Write([]byte(envelope))
+	return mac.Sum(nil)
 }
@@ -0,0 +1,127 @@
+package infrastructure
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/domain"
+	"github.com/bernardbaker/qiba.core/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypter(t *testing.T) {
+	ticket := domain.GameTicket{GameID: "game1", UserID: "42", Mode: "timed", StartTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	ticket.ExpiresAt = ticket.StartTime.Add(time.Minute)
+	// newEncrypter seals with a keyring file holding ids, current first
+	newEncrypter := func(t *testing.T, ids ...string) (*Encrypter, *Keyring, string) {
+		path := writeKeyring(t, filepath.Join(t.TempDir(), "keys.json"), ids[0], ids...)
+		keys, err := LoadKeyringFile(path)
+		assert.NoError(t, err)
+		return NewEncrypter(keys), keys, path
+	}
+
+	t.Run("sealed data opens", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1")
+
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(data, "k1."))
+
+		var opened domain.GameTicket
+		assert.NoError(t, encrypter.DecryptGameData(data, mac, &opened))
+		assert.Equal(t, ticket, opened)
+		assert.NoError(t, encrypter.VerifyGameData(data, mac))
+	})
+
+	t.Run("data sealed with an old key opens after rotation", func(t *testing.T) {
+		encrypter, keys, path := newEncrypter(t, "k1")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		writeKeyring(t, path, "k2", "k1", "k2")
+		assert.NoError(t, keys.Reload())
+
+		var opened domain.GameTicket
+		assert.NoError(t, encrypter.DecryptGameData(data, mac, &opened))
+		assert.Equal(t, ticket, opened)
+		sealed, _, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(sealed, "k2."))
+	})
+
+	t.Run("data sealed with a removed key is refused", func(t *testing.T) {
+		encrypter, keys, path := newEncrypter(t, "k1")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		writeKeyring(t, path, "k2", "k2")
+		assert.NoError(t, keys.Reload())
+
+		err = encrypter.VerifyGameData(data, mac)
+		assert.ErrorIs(t, err, ports.ErrGameDataTampered)
+		assert.ErrorContains(t, err, ErrUnknownKey.Error())
+	})
+
+	t.Run("an unknown key id is refused", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		err = encrypter.VerifyGameData("k9"+strings.TrimPrefix(data, "k1"), mac)
+
+		assert.ErrorIs(t, err, ports.ErrGameDataTampered)
+		assert.ErrorContains(t, err, ErrUnknownKey.Error())
+	})
+
+	t.Run("moving data to another key fails the hmac", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1", "k2")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		err = encrypter.VerifyGameData("k2"+strings.TrimPrefix(data, "k1"), mac)
+
+		assert.ErrorIs(t, err, ports.ErrGameDataTampered)
+		assert.ErrorContains(t, err, "hmac mismatch")
+	})
+
+	t.Run("a changed ciphertext fails the hmac", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+		keyID, payload, _ := strings.Cut(data, ".")
+		ciphertext, err := base64.StdEncoding.DecodeString(payload)
+		assert.NoError(t, err)
+		ciphertext[len(ciphertext)-1] ^= 1
+		tampered := keyID + "." + base64.StdEncoding.EncodeToString(ciphertext)
+
+		var opened domain.GameTicket
+		err = encrypter.DecryptGameData(tampered, mac, &opened)
+
+		assert.ErrorIs(t, err, ports.ErrGameDataTampered)
+		assert.ErrorContains(t, err, "hmac mismatch")
+	})
+
+	t.Run("another envelope's hmac is refused", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1")
+		data, _, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+		_, otherMac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		err = encrypter.VerifyGameData(data, otherMac)
+
+		assert.ErrorIs(t, err, ports.ErrGameDataTampered)
+	})
+
+	t.Run("malformed data is refused", func(t *testing.T) {
+		encrypter, _, _ := newEncrypter(t, "k1")
+		data, mac, err := encrypter.EncryptGameData(ticket)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, encrypter.VerifyGameData("no-key-id", mac), ports.ErrGameDataTampered)
+		assert.ErrorIs(t, encrypter.VerifyGameData(data, "not base64!"), ports.ErrGameDataTampered)
+	})
+}
@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"sync"
+
+	"github.com/bernardbaker/qiba.core/domain"
+)
+
+type InMemoryGameEventRepository struct {
+	events map[string][]domain.GameEvent
+	mutex  sync.RWMutex
+}
+
+func NewInMemoryGameEventRepository() *InMemoryGameEventRepository {
+	return &InMemoryGameEventRepository{
+		events: make(map[string][]domain.GameEvent),
+	}
+}
+
+// AppendEvent adds an event to the end of its game's log
+func (repo *InMemoryGameEventRepository) AppendEvent(event *domain.GameEvent) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	event.Sequence = int64(len(repo.events[event.GameID]) + 1)
+	// Store a copy so the log cannot be changed after the fact
+	repo.events[event.GameID] = append(repo.events[event.GameID], *event)
+	return nil
+}
+
+// GetEvents retrieves a game's events in order
+func (repo *InMemoryGameEventRepository) GetEvents(gameID string) ([]*domain.GameEvent, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	stored := repo.events[gameID]
+	events := make([]*domain.GameEvent, 0, len(stored))
+	for i := range stored {
+		event := stored[i]
+		events = append(events, &event)
+	}
+	return events, nil
+}
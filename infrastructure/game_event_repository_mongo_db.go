@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bernardbaker/qiba.core/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Concurrent appends to one game race for the same sequence number, the
+// unique index makes the loser retry with the next one.
+const appendEventAttempts = 5
+
+type MongoDbGameEventRepository struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewMongoDbGameEventRepository() *MongoDbGameEventRepository {
+	// Use the SetServerAPIOptions() method to set the version of the Stable API on the client
+	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
+	opts := options.Client().ApplyURI("mongodb+srv://" + os.Getenv("MONGO_DB_USER") + ":" + os.Getenv("MONGO_DB_PASSWORD") + "@" + os.Getenv("MONGO_DB_URL") + "/?retryWrites=true&w=majority&appName=qiba-game").SetServerAPIOptions(serverAPI)
+	// Create a new client and connect to the server
+	client, err := mongo.Connect(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Game event repository - connection to MongoDB failed!")
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	// Send a ping to confirm a successful connection
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
+		panic(err)
+	}
+	fmt.Println("Game event repository - Pinged your deployment. You successfully connected to MongoDB!")
+
+	collection := client.Database("qiba-game").Collection("game_events")
+	_, err = collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "GameID", Value: 1}, {Key: "Sequence", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &MongoDbGameEventRepository{
+		client:     client,
+		collection: collection,
+	}
+}
+
+// AppendEvent adds an event to the end of its game's log in MongoDB
+func (repo *MongoDbGameEventRepository) AppendEvent(event *domain.GameEvent) error {
+	ctx := context.Background()
+	for attempt := 0; attempt < appendEventAttempts; attempt++ {
+		count, err := repo.collection.CountDocuments(ctx, bson.M{"GameID": event.GameID})
+		if err != nil {
+			return fmt.Errorf("failed to count game events: %w", err)
+		}
+		event.Sequence = count + 1
+		_, err = repo.collection.InsertOne(ctx, event)
+		if mongo.IsDuplicateKeyError(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to append game event: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("failed to append game event for game %s: too many concurrent appends", event.GameID)
+}
+
+// GetEvents retrieves a game's events from MongoDB in order
+func (repo *MongoDbGameEventRepository) GetEvents(gameID string) ([]*domain.GameEvent, error) {
+	ctx := context.Background()
+	opts := options.Find().SetSort(bson.D{{Key: "Sequence", Value: 1}})
+
+	cursor, err := repo.collection.Find(ctx, bson.M{"GameID": gameID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching game events: %w", err)
+	}
+
+	var events []*domain.GameEvent
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("error decoding game events: %w", err)
+	}
+
+	return events, nil
+}
@@ -2,7 +2,9 @@ package infrastructure
 
 import (
 	"errors"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
 )
@@ -61,3 +63,47 @@ func (repo *InMemoryGameRepository) GetGamesByUser(userID string) ([]*domain.Gam
 	}
 	return games, nil
 }
+
+// GetExpiredGames returns the open or expired games whose duration ran out
+// before now and that were never finalized
+func (repo *InMemoryGameRepository) GetExpiredGames(now time.Time) ([]*domain.Game, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	var games []*domain.Game
+	for _, game := range repo.games {
+		if (game.IsOpen() || game.Status == domain.GameStatusExpired) && !game.IsFinalized() && game.ExpiresAt.Before(now) {
+			games = append(games, game)
+		}
+	}
+	return games, nil
+}
+
+// ListGames returns one page of the games matching query, without their
+// object sequences
+func (repo *InMemoryGameRepository) ListGames(query domain.GameQuery) (*domain.GamePage, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	var games []*domain.Game
+	for _, game := range repo.games {
+		if query.Matches(game) {
+			// Like MongoDB, list games without their object sequence
+			listed := *game
+			listed.ObjectSeq = nil
+			games = append(games, &listed)
+		}
+	}
+	slices.SortFunc(games, func(a, b *domain.Game) int {
+		if domain.CursorOf(a).Less(domain.CursorOf(b)) == query.Ascending {
+			return -1
+		}
+		return 1
+	})
+
+	page := &domain.GamePage{Games: games}
+	if limit := query.PageSize(); len(games) > limit {
+		page.Games = games[:limit]
+		next := domain.CursorOf(page.Games[limit-1])
+		page.Next = &next
+	}
+	return page, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
 	"go.mongodb.org/mongo-driver/bson"
@@ -32,14 +33,23 @@ func NewMongoDbGameRepository() *MongoDbGameRepository {
 	}
 
 	// Send a ping to confirm a successful connection
-	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{"ping", 1}}).Err(); err != nil {
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
 		panic(err)
 	}
 	fmt.Println("Game repository - Pinged your deployment. You successfully connected to MongoDB!")
 
+	collection := client.Database("qiba-game").Collection("games")
+	// Serves ListGames in either direction
+	_, err = collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "UserID", Value: 1}, {Key: "StartTime", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &MongoDbGameRepository{
 		client:     client,
-		collection: client.Database("qiba-game").Collection("games"),
+		collection: collection,
 	}
 }
 
@@ -49,12 +59,26 @@ func (repo *MongoDbGameRepository) SaveGame(game *domain.Game) error {
 	filter := bson.M{"_id": game.ID}
 
 	update := bson.M{"$set": bson.M{
-		"EndTime":   game.EndTime,
-		"ID":        game.ID,
-		"ObjectSeq": game.ObjectSeq,
-		"Score":     game.Score,
-		"StartTime": game.StartTime,
-		"UserID":    game.UserID,
+		"EndTime":          game.EndTime,
+		"ExpiresAt":        game.ExpiresAt,
+		"Status":           game.Status,
+		"Streak":           game.Streak,
+		"Mistakes":         game.Mistakes,
+		"MaxMistakes":      game.MaxMistakes,
+		"ID":               game.ID,
+		"ObjectSeq":        game.ObjectSeq,
+		"Score":            game.Score,
+		"StartTime":        game.StartTime,
+		"UserID":           game.UserID,
+		"Seed":             game.Seed,
+		"GeneratorVersion": game.GeneratorVersion,
+		"Mode":             game.Mode,
+		"RulesVersion":     game.RulesVersion,
+		"Practice":         game.Practice,
+		"Reaction":         game.Reaction,
+		"Verification":     game.Verification,
+		"Result":           game.Result,
+		"Risk":             game.Risk,
 	}}
 	opts := options.Update().SetUpsert(true)
 
@@ -88,12 +112,26 @@ func (repo *MongoDbGameRepository) UpdateGame(game *domain.Game) error {
 	ctx := context.Background()
 	filter := bson.M{"_id": game.ID}
 	update := bson.M{"$set": bson.M{
-		"EndTime":   game.EndTime,
-		"ID":        game.ID,
-		"ObjectSeq": game.ObjectSeq,
-		"Score":     game.Score,
-		"StartTime": game.StartTime,
-		"UserID":    game.UserID,
+		"EndTime":          game.EndTime,
+		"ExpiresAt":        game.ExpiresAt,
+		"Status":           game.Status,
+		"Streak":           game.Streak,
+		"Mistakes":         game.Mistakes,
+		"MaxMistakes":      game.MaxMistakes,
+		"ID":               game.ID,
+		"ObjectSeq":        game.ObjectSeq,
+		"Score":            game.Score,
+		"StartTime":        game.StartTime,
+		"UserID":           game.UserID,
+		"Seed":             game.Seed,
+		"GeneratorVersion": game.GeneratorVersion,
+		"Mode":             game.Mode,
+		"RulesVersion":     game.RulesVersion,
+		"Practice":         game.Practice,
+		"Reaction":         game.Reaction,
+		"Verification":     game.Verification,
+		"Result":           game.Result,
+		"Risk":             game.Risk,
 	}}
 
 	result, err := repo.collection.UpdateOne(ctx, filter, update)
@@ -142,3 +180,84 @@ func (repo *MongoDbGameRepository) GetGamesByUser(userID string) ([]*domain.Game
 
 	return games, nil
 }
+
+// GetExpiredGames retrieves the open or expired games whose duration ran out
+// before now and that were never finalized
+func (repo *MongoDbGameRepository) GetExpiredGames(now time.Time) ([]*domain.Game, error) {
+	ctx := context.Background()
+	filter := bson.M{
+		"Status":    bson.M{"$in": []domain.GameStatus{domain.GameStatusCreated, domain.GameStatusRunning, domain.GameStatusExpired}},
+		"ExpiresAt": bson.M{"$lt": now},
+		// Matches games stored with a null or without a result
+		"Result": nil,
+	}
+
+	cursor, err := repo.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching expired games: %w", err)
+	}
+
+	var games []*domain.Game
+	if err = cursor.All(ctx, &games); err != nil {
+		return nil, fmt.Errorf("error decoding expired games: %w", err)
+	}
+
+	return games, nil
+}
+
+// ListGames retrieves one page of the games matching query from MongoDB.
+// Object sequences are not loaded.
+func (repo *MongoDbGameRepository) ListGames(query domain.GameQuery) (*domain.GamePage, error) {
+	ctx := context.Background()
+	filter := bson.M{"UserID": query.UserID}
+	if query.Mode != "" {
+		filter["Mode"] = query.Mode
+	}
+
+	startTime := bson.M{}
+	if !query.From.IsZero() {
+		startTime["$gte"] = query.From
+	}
+	if !query.To.IsZero() {
+		startTime["$lt"] = query.To
+	}
+	if len(startTime) > 0 {
+		filter["StartTime"] = startTime
+	}
+
+	direction, after := -1, "$lt"
+	if query.Ascending {
+		direction, after = 1, "$gt"
+	}
+	if query.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"StartTime": bson.M{after: query.After.StartTime}},
+			bson.M{"StartTime": query.After.StartTime, "_id": bson.M{after: query.After.ID}},
+		}
+	}
+
+	limit := query.PageSize()
+	// One extra game tells whether another page follows
+	opts := options.Find().
+		SetSort(bson.D{{Key: "StartTime", Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(limit + 1)).
+		SetProjection(bson.M{"ObjectSeq": 0})
+
+	cursor, err := repo.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing games: %w", err)
+	}
+
+	var games []*domain.Game
+	if err = cursor.All(ctx, &games); err != nil {
+		return nil, fmt.Errorf("error decoding games: %w", err)
+	}
+
+	page := &domain.GamePage{Games: games}
+	if len(games) > limit {
+		page.Games = games[:limit]
+		next := domain.CursorOf(page.Games[limit-1])
+		page.Next = &next
+	}
+	return page, nil
+}
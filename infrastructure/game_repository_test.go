@@ -0,0 +1,162 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/domain"
+	"github.com/bernardbaker/qiba.core/ports"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestInMemoryGameRepositoryListGames(t *testing.T) {
+	testListGames(t, func(t *testing.T) ports.GameRepository {
+		return NewInMemoryGameRepository()
+	})
+}
+
+// Runs against the MongoDB at MONGO_DB_TEST_URI, in a database dropped when
+// the test ends
+func TestMongoDbGameRepositoryListGames(t *testing.T) {
+	uri := os.Getenv("MONGO_DB_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_DB_TEST_URI is not set")
+	}
+	testListGames(t, func(t *testing.T) ports.GameRepository {
+		ctx := context.Background()
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+		if err != nil {
+			t.Fatal(err)
+		}
+		database := client.Database(fmt.Sprintf("qiba-test-%d", time.Now().UnixNano()))
+		t.Cleanup(func() {
+			database.Drop(ctx)
+			client.Disconnect(ctx)
+		})
+		return &MongoDbGameRepository{client: client, collection: database.Collection("games")}
+	})
+}
+
+// testListGames pages through games saved to the repositories newRepo makes
+func testListGames(t *testing.T, newRepo func(t *testing.T) ports.GameRepository) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	// Pairs of games share a start time and are saved out of ID order
+	saved := []struct {
+		id     string
+		minute int
+	}{
+		{"game-b", 0}, {"game-a", 0},
+		{"game-d", 1}, {"game-c", 1},
+		{"game-f", 2}, {"game-e", 2},
+		{"game-g", 3},
+	}
+	ascending := []string{"game-a", "game-b", "game-c", "game-d", "game-e", "game-f", "game-g"}
+	descending := []string{"game-g", "game-f", "game-e", "game-d", "game-c", "game-b", "game-a"}
+
+	seed := func(t *testing.T) ports.GameRepository {
+		repo := newRepo(t)
+		for _, s := range saved {
+			game := domain.NewGameWithSeed("1", 7, &domain.DefaultRules, time.Minute)
+			game.ID = s.id
+			game.StartTime = start.Add(time.Duration(s.minute) * time.Minute)
+			for i := 0; i < 2; i++ {
+				assert.NoError(t, game.GenerateObjectSequence(&domain.DefaultRules))
+			}
+			assert.NoError(t, repo.SaveGame(game))
+		}
+		// Another user's game at the same time is never listed
+		other := domain.NewGameWithSeed("2", 7, &domain.DefaultRules, time.Minute)
+		other.ID = "game-0"
+		other.StartTime = start
+		assert.NoError(t, repo.SaveGame(other))
+		return repo
+	}
+	// walk follows the cursor from the first page to the last and returns the
+	// IDs in the order listed and the size of each page
+	walk := func(t *testing.T, repo ports.GameRepository, query domain.GameQuery) ([]string, []int) {
+		var ids []string
+		var sizes []int
+		for {
+			page, err := repo.ListGames(query)
+			if !assert.NoError(t, err) {
+				return ids, sizes
+			}
+			sizes = append(sizes, len(page.Games))
+			for _, game := range page.Games {
+				ids = append(ids, game.ID)
+				assert.Empty(t, game.ObjectSeq, game.ID)
+			}
+			if page.Next == nil {
+				return ids, sizes
+			}
+			// Continue from the token a client would send back
+			after, err := domain.DecodeGameCursor(page.Next.Encode())
+			assert.NoError(t, err)
+			query.After = after
+			if len(sizes) > len(saved) {
+				t.Fatal("cursor does not advance")
+			}
+		}
+	}
+
+	t.Run("cursor walks every game once, ties broken by ID", func(t *testing.T) {
+		repo := seed(t)
+
+		ids, sizes := walk(t, repo, domain.GameQuery{UserID: "1", Ascending: true, Limit: 3})
+		assert.Equal(t, ascending, ids)
+		assert.Equal(t, []int{3, 3, 1}, sizes)
+
+		ids, sizes = walk(t, repo, domain.GameQuery{UserID: "1", Limit: 3})
+		assert.Equal(t, descending, ids)
+		assert.Equal(t, []int{3, 3, 1}, sizes)
+	})
+
+	t.Run("a page splitting a tie continues with the other game", func(t *testing.T) {
+		repo := seed(t)
+
+		ids, sizes := walk(t, repo, domain.GameQuery{UserID: "1", Ascending: true, Limit: 1})
+
+		assert.Equal(t, ascending, ids)
+		assert.Len(t, sizes, len(saved))
+	})
+
+	t.Run("a full last page has no next page", func(t *testing.T) {
+		repo := seed(t)
+
+		page, err := repo.ListGames(domain.GameQuery{UserID: "1", Limit: len(saved)})
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Games, len(saved))
+		assert.Nil(t, page.Next)
+	})
+
+	t.Run("the end of the list is an empty page", func(t *testing.T) {
+		repo := seed(t)
+		last := domain.GameCursor{StartTime: start.Add(3 * time.Minute), ID: "game-g"}
+
+		page, err := repo.ListGames(domain.GameQuery{UserID: "1", Ascending: true, After: &last})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Games)
+		assert.Nil(t, page.Next)
+	})
+
+	t.Run("object sequences are left out and kept in the store", func(t *testing.T) {
+		repo := seed(t)
+
+		page, err := repo.ListGames(domain.GameQuery{UserID: "1", Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, page.Games, 1) {
+			assert.Empty(t, page.Games[0].ObjectSeq)
+		}
+
+		stored, err := repo.GetGame("game-g")
+		assert.NoError(t, err)
+		assert.Len(t, stored.ObjectSeq, 2)
+	})
+}
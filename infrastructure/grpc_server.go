@@ -2,15 +2,24 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/bernardbaker/qiba.core/app"
+	"github.com/bernardbaker/qiba.core/auth"
 	"github.com/bernardbaker/qiba.core/domain"
+	"github.com/bernardbaker/qiba.core/ports"
 	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/receipt"
+	"github.com/bernardbaker/qiba.core/telegram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GameServer struct {
@@ -23,75 +32,376 @@ func NewGameServer(service *app.GameService) *GameServer {
 }
 
 func (s *GameServer) StartGame(ctx context.Context, req *proto.StartGameRequest) (*proto.StartGameResponse, error) {
-	id := strconv.FormatInt(req.User.UserId, 10)
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
-	}
-	encryptedData, hmac, gameID, err := s.service.StartGame(id, user)
+	user, err := caller(ctx, req.User)
 	if err != nil {
 		return nil, err
 	}
-	return &proto.StartGameResponse{EncryptedGameData: encryptedData, Hmac: hmac, GameId: gameID}, nil
+	id := strconv.FormatInt(user.UserId, 10)
+	encryptedData, hmac, game, err := s.service.StartGame(id, user, req.Mode)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.StartGameResponse{
+		EncryptedGameData: encryptedData,
+		Hmac:              hmac,
+		GameId:            game.ID,
+		Mode:              game.Mode,
+		Time:              int32(game.ExpiresAt.Sub(game.StartTime) / time.Second),
+		MaxMistakes:       game.MaxMistakes,
+	}, nil
 }
 
 func (s *GameServer) Spawn(ctx context.Context, req *proto.SpawnRequest) (*proto.SpawnResponse, error) {
-	data, err := s.service.Spawn(req.GameId)
+	if err := s.authorizeGame(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+	obj, err := s.service.Spawn(req.GameId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	// Older clients parse the JSON encoded object
+	data, err := json.Marshal(obj)
 	if err != nil {
 		return nil, err
 	}
-	return &proto.SpawnResponse{Data: data}, nil
+	return &proto.SpawnResponse{Data: string(data), Object: toProtoGameObject(obj)}, nil
+}
+
+// SpawnStream pushes the game's objects as the server spawns them, followed
+// by a game over message once the game's duration runs out
+func (s *GameServer) SpawnStream(req *proto.SpawnStreamRequest, stream proto.GameService_SpawnStreamServer) error {
+	if err := s.authorizeGame(stream.Context(), req.GameId); err != nil {
+		return err
+	}
+	game, err := s.service.StreamSpawns(stream.Context(), req.GameId, func(obj *domain.GameObject) error {
+		return stream.Send(&proto.SpawnEvent{Event: &proto.SpawnEvent_Object{Object: toProtoGameObject(obj)}})
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+	return stream.Send(&proto.SpawnEvent{Event: &proto.SpawnEvent_GameOver{GameOver: toProtoGameOver(game)}})
+}
+
+// PlaySession runs a whole game over one stream. The client starts the
+// session with a game ID and then sends taps; the server sends spawns, tap
+// verdicts, score updates and finally the game over message. The game is
+// ended and finalized before game over, which carries its result.
+func (s *GameServer) PlaySession(stream proto.GameService_PlaySessionServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil || start.GameId == "" {
+		return status.Error(codes.InvalidArgument, "play session must start with a game id")
+	}
+	if err := s.authorizeGame(stream.Context(), start.GameId); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	session := &playSession{stream: stream}
+	defer session.close()
+
+	taps := make(chan error, 1)
+	go func() {
+		taps <- s.receiveTaps(start.GameId, session)
+	}()
+
+	spawned := make(chan error, 1)
+	var game *domain.Game
+	go func() {
+		var err error
+		game, err = s.service.StreamSpawns(ctx, start.GameId, func(obj *domain.GameObject) error {
+			return session.send(&proto.PlayEvent{Event: &proto.PlayEvent_Spawn{Spawn: toProtoGameObject(obj)}})
+		})
+		spawned <- err
+	}()
+
+	for {
+		select {
+		case err := <-taps:
+			if err != nil {
+				cancel()
+				<-spawned
+				return toStatusError(err)
+			}
+			// The client stopped sending taps, keep spawning until game over
+			taps = nil
+		case err := <-spawned:
+			if err != nil {
+				return toStatusError(err)
+			}
+			return session.send(&proto.PlayEvent{Event: &proto.PlayEvent_GameOver{GameOver: toProtoGameOver(game)}})
+		}
+	}
+}
+
+// receiveTaps scores the session's taps until the client closes its side
+func (s *GameServer) receiveTaps(gameID string, session *playSession) error {
+	for {
+		req, err := session.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tap := req.GetTap()
+		if tap == nil {
+			return status.Error(codes.InvalidArgument, "play session expects taps after the start message")
+		}
+		timestamp, _ := time.Parse(time.RFC3339, tap.Timestamp)
+		outcome, err := s.service.Tap(gameID, tap.ObjectId, timestamp)
+		if errors.Is(err, domain.ErrGameExpired) || errors.Is(err, domain.ErrGameEnded) || errors.Is(err, domain.ErrNoMistakesLeft) {
+			// Late taps are dropped, the game over message follows
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		verdict := &proto.TapVerdict{
+			ObjectId:        tap.ObjectId,
+			Result:          toProtoTapResult(outcome.Result),
+			ServerTimestamp: outcome.Timestamp.UTC().Format(time.RFC3339Nano),
+			ReactionMs:      outcome.ReactionTime.Milliseconds(),
+		}
+		if err := session.send(&proto.PlayEvent{Event: &proto.PlayEvent_TapResult{TapResult: verdict}}); err != nil {
+			return err
+		}
+		score := &proto.ScoreUpdate{Score: outcome.Score, Streak: outcome.Streak}
+		if err := session.send(&proto.PlayEvent{Event: &proto.PlayEvent_Score{Score: score}}); err != nil {
+			return err
+		}
+	}
+}
+
+// playSession serialises sends from the spawn and tap goroutines, and drops
+// them once the handler has returned
+type playSession struct {
+	stream proto.GameService_PlaySessionServer
+	mutex  sync.Mutex
+	closed bool
+}
+
+func (p *playSession) send(event *proto.PlayEvent) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return io.ErrClosedPipe
+	}
+	return p.stream.Send(event)
+}
+
+func (p *playSession) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+}
+
+func toProtoGameOver(game *domain.Game) *proto.GameOver {
+	over := &proto.GameOver{Score: game.Score, Status: string(game.Status)}
+	if result := game.Result; result != nil {
+		over.Score = result.Score
+		over.Verified = result.Verified
+		over.Receipt = result.Receipt
+		over.UnderReview = result.UnderReview
+		over.Rejected = result.Rejected
+	}
+	return over
+}
+
+func toProtoGameObject(obj *domain.GameObject) *proto.GameObject {
+	return &proto.GameObject{
+		Id:         obj.ID,
+		Type:       obj.Type,
+		SpawnTime:  obj.Timestamp.UTC().Format(time.RFC3339Nano),
+		LifetimeMs: obj.Lifetime.Milliseconds(),
+		Points:     obj.Points,
+	}
 }
 
 func (s *GameServer) Tap(ctx context.Context, req *proto.TapRequest) (*proto.TapResponse, error) {
+	if err := s.authorizeGame(ctx, req.GameId); err != nil {
+		return nil, err
+	}
 	timestamp, _ := time.Parse(time.RFC3339, req.Timestamp)
-	success, err := s.service.Tap(req.GameId, req.ObjectId, timestamp)
+	outcome, err := s.service.Tap(req.GameId, req.ObjectId, timestamp)
 	if err != nil {
 		fmt.Println(err)
-		return nil, err
+		return nil, toStatusError(err)
+	}
+	return &proto.TapResponse{
+		Success:         outcome.Result == domain.TapAccepted,
+		Result:          toProtoTapResult(outcome.Result),
+		Score:           outcome.Score,
+		Streak:          outcome.Streak,
+		ServerTimestamp: outcome.Timestamp.UTC().Format(time.RFC3339Nano),
+		ReactionMs:      outcome.ReactionTime.Milliseconds(),
+	}, nil
+}
+
+func toProtoTapResult(result domain.TapResult) proto.TapResult {
+	switch result {
+	case domain.TapAccepted:
+		return proto.TapResult_TAP_RESULT_ACCEPTED
+	case domain.TapDuplicate:
+		return proto.TapResult_TAP_RESULT_DUPLICATE
+	case domain.TapMiss:
+		return proto.TapResult_TAP_RESULT_MISS
+	case domain.TapUnknownObject:
+		return proto.TapResult_TAP_RESULT_UNKNOWN_OBJECT
+	case domain.TapTooFast:
+		return proto.TapResult_TAP_RESULT_TOO_FAST
+	default:
+		return proto.TapResult_TAP_RESULT_UNSPECIFIED
 	}
-	return &proto.TapResponse{Success: success}, nil
 }
 
 func (s *GameServer) EndGame(ctx context.Context, req *proto.EndGameRequest) (*proto.EndGameResponse, error) {
 	fmt.Println("")
 	fmt.Println("start gRPC Server EndGame")
-	score, err := s.service.EndGame(req.GameId)
-	if err != nil {
+	// A claimed user must match the session, the game must be the caller's
+	if _, err := caller(ctx, req.User); err != nil {
 		return nil, err
 	}
-	fmt.Printf("User scored %d\n", score)
-	// create a user
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	if err := s.authorizeGame(ctx, req.GameId); err != nil {
+		return nil, err
 	}
-	fmt.Println("EndGame user", user)
-
-	_, addErr := s.service.AddToLeaderboard(user, score)
-	if addErr != nil {
-		fmt.Println("table, addErr := s.service.AddToLeaderboard(user, score)", addErr)
-		return nil, addErr
+	// Clients that kept the game data from StartGame echo it back
+	if req.EncryptedGameData != "" || req.Hmac != "" {
+		if err := s.service.CheckGameData(req.GameId, req.EncryptedGameData, req.Hmac); err != nil {
+			return nil, toStatusError(err)
+		}
 	}
+	// Retries get the result stored by the first call
+	game, err := s.service.EndGame(req.GameId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	result := game.Result
+	fmt.Printf("User scored %d\n", result.Score)
 	fmt.Println("end gRPC Server EndGame")
 	fmt.Println("")
-	// TODO Using the returned user update the Table
-	return &proto.EndGameResponse{Score: score}, nil
+	return toProtoGameResult(result), nil
+}
+
+func toProtoGameResult(result *domain.GameResult) *proto.EndGameResponse {
+	return &proto.EndGameResponse{Score: result.Score, Verified: result.Verified, Receipt: result.Receipt, UnderReview: result.UnderReview, Rejected: result.Rejected}
+}
+
+// ReceiptKeys returns the public keys score receipts are signed with
+func (s *GameServer) ReceiptKeys(ctx context.Context, req *proto.ReceiptKeysRequest) (*proto.ReceiptKeysResponse, error) {
+	keys := s.service.ReceiptKeys()
+	res := &proto.ReceiptKeysResponse{Keys: make([]*proto.ReceiptKey, 0, len(keys))}
+	for _, key := range keys {
+		res.Keys = append(res.Keys, &proto.ReceiptKey{
+			KeyId:     key.ID,
+			Algorithm: receipt.Algorithm,
+			PublicKey: key.Encoded(),
+		})
+	}
+	return res, nil
+}
+
+// GetGameReplay returns the ordered event log of a game
+func (s *GameServer) GetGameReplay(ctx context.Context, req *proto.GameReplayRequest) (*proto.GameReplayResponse, error) {
+	if err := s.authorizeGame(ctx, req.GameId); err != nil {
+		return nil, err
+	}
+	events, err := s.service.GetGameEvents(req.GameId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	replay := &proto.GameReplayResponse{GameId: req.GameId, Events: make([]*proto.GameEvent, 0, len(events))}
+	for _, event := range events {
+		replay.Events = append(replay.Events, toProtoGameEvent(event))
+	}
+	return replay, nil
+}
+
+func toProtoGameEvent(event *domain.GameEvent) *proto.GameEvent {
+	protoEvent := &proto.GameEvent{
+		Sequence:   event.Sequence,
+		Type:       string(event.Type),
+		ObjectId:   event.ObjectID,
+		ObjectType: event.ObjectType,
+		Points:     event.Points,
+		TapResult:  toProtoTapResult(event.TapResult),
+		Reason:     event.Reason,
+		ReactionMs: event.ReactionTime.Milliseconds(),
+		Score:      event.Score,
+		Timestamp:  event.Timestamp.UTC().Format(time.RFC3339Nano),
+	}
+	if !event.TappedAt.IsZero() {
+		protoEvent.TappedAt = event.TappedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return protoEvent
+}
+
+// ListGames returns one page of the user's past games
+func (s *GameServer) ListGames(ctx context.Context, req *proto.ListGamesRequest) (*proto.ListGamesResponse, error) {
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	query := domain.GameQuery{
+		UserID:    strconv.FormatInt(user.UserId, 10),
+		Mode:      req.Mode,
+		Ascending: req.OldestFirst,
+		Limit:     int(req.PageSize),
+	}
+	if req.From != "" {
+		if query.From, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+	}
+	if req.To != "" {
+		if query.To, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+	}
+	if req.PageToken != "" {
+		if query.After, err = domain.DecodeGameCursor(req.PageToken); err != nil {
+			return nil, toStatusError(err)
+		}
+	}
+
+	page, err := s.service.ListGames(query)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &proto.ListGamesResponse{Games: make([]*proto.GameSummary, 0, len(page.Games))}
+	for _, game := range page.Games {
+		resp.Games = append(resp.Games, toProtoGameSummary(game))
+	}
+	if page.Next != nil {
+		resp.NextPageToken = page.Next.Encode()
+	}
+	return resp, nil
+}
+
+func toProtoGameSummary(game *domain.Game) *proto.GameSummary {
+	return &proto.GameSummary{
+		GameId:           game.ID,
+		Score:            game.Score,
+		Status:           string(game.Status),
+		Mode:             game.Mode,
+		RulesVersion:     game.RulesVersion,
+		StartTime:        game.StartTime.UTC().Format(time.RFC3339Nano),
+		EndTime:          game.EndTime.UTC().Format(time.RFC3339Nano),
+		Verified:         game.Verification.Verified(),
+		MedianReactionMs: game.Reaction.Median.Milliseconds(),
+		Practice:         game.Practice,
+	}
 }
 
 // TODO: regenerate proto files and reupload API gateway
 func (s *GameServer) CanPlay(ctx context.Context, req *proto.CanPlayGameRequest) (*proto.CanPlayGameResponse, error) {
-	// Convert string to int64 first if req.Timestamp is a string
-	user := domain.User{
-		UserId: req.User.UserId,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
 
 	//TODO: going to use the server time - refactor this
@@ -104,76 +414,233 @@ func (s *GameServer) Leaderboard(ctx context.Context, req *proto.LeaderboardRequ
 	fmt.Println("")
 	fmt.Println("Leaderboard")
 
-	// create a user
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
-	fmt.Println("req.User", user)
+	fmt.Println("Leaderboard user", user)
 
-	var name string
-	if os.Getenv("ENV") == "development" {
+	day := time.Now()
+	if req.Date != "" {
+		if day, err = time.Parse(time.DateOnly, req.Date); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
+		}
+	}
+	name, err := s.service.LeaderboardName(req.Mode, day)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "game mode %q has no leaderboard", req.Mode)
+	}
+	if os.Getenv("ENV") == "development" && (req.Mode == "" || req.Mode == domain.DefaultGameMode) {
 		name = "dev"
-	} else {
-		name = "qiba"
+	}
+
+	// Leaderboard is public, so a claimed user is not trusted to see the
+	// scores that are hidden from everyone but their owner
+	var viewer *domain.User
+	if _, ok := auth.FromContext(ctx); ok {
+		viewer = &user
 	}
 
 	// Get the domain table
-	jsonString, usersString, err := s.service.GetLeaderboard(name, &user)
+	jsonString, usersString, err := s.service.GetLeaderboard(name, &user, viewer)
 	if err != nil {
 		return nil, err
 	}
 	return &proto.LeaderboardResponse{Success: true, Table: jsonString, UserScore: usersString}, nil
 }
 
+// ModerateUser changes a user's moderation status. The moderator is the
+// session's user, claimed users are not trusted here.
+func (s *GameServer) ModerateUser(ctx context.Context, req *proto.ModerateUserRequest) (*proto.ModerateUserResponse, error) {
+	session, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "session token required")
+	}
+	moderation, err := domain.ParseModerationStatus(req.Status)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	moderatorId := strconv.FormatInt(session.UserID, 10)
+	userId := strconv.FormatInt(req.UserId, 10)
+	if err := s.service.ModerateUser(moderatorId, userId, moderation); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.ModerateUserResponse{Success: true, Status: string(moderation)}, nil
+}
+
+// ListReviews returns the games held for review. The moderator is the
+// session's user.
+func (s *GameServer) ListReviews(ctx context.Context, req *proto.ListReviewsRequest) (*proto.ListReviewsResponse, error) {
+	session, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "session token required")
+	}
+	reviewStatus := domain.ReviewPending
+	if req.Status != "" {
+		var err error
+		if reviewStatus, err = domain.ParseReviewStatus(req.Status); err != nil {
+			return nil, toStatusError(err)
+		}
+	}
+	reviews, err := s.service.ListReviews(strconv.FormatInt(session.UserID, 10), reviewStatus, int(req.Limit))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	res := &proto.ListReviewsResponse{Reviews: make([]*proto.GameReview, 0, len(reviews))}
+	for _, review := range reviews {
+		res.Reviews = append(res.Reviews, toProtoGameReview(review))
+	}
+	return res, nil
+}
+
+// ApproveReview publishes a score held for review
+func (s *GameServer) ApproveReview(ctx context.Context, req *proto.ReviewDecisionRequest) (*proto.ReviewDecisionResponse, error) {
+	return s.decideReview(ctx, req.GameId, s.service.ApproveReview)
+}
+
+// RejectReview keeps a score held for review off the leaderboard
+func (s *GameServer) RejectReview(ctx context.Context, req *proto.ReviewDecisionRequest) (*proto.ReviewDecisionResponse, error) {
+	return s.decideReview(ctx, req.GameId, s.service.RejectReview)
+}
+
+func (s *GameServer) decideReview(ctx context.Context, gameID string, decide func(moderatorId, gameID string) (*domain.Game, error)) (*proto.ReviewDecisionResponse, error) {
+	session, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "session token required")
+	}
+	game, err := decide(strconv.FormatInt(session.UserID, 10), gameID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	review, err := s.service.GetReview(gameID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.ReviewDecisionResponse{Review: toProtoGameReview(review), Result: toProtoGameResult(game.Result)}, nil
+}
+
+func toProtoGameReview(review *domain.GameReview) *proto.GameReview {
+	res := &proto.GameReview{
+		GameId:      review.GameID,
+		Mode:        review.Mode,
+		Score:       review.Score,
+		Leaderboard: review.Leaderboard,
+		Risk:        review.Risk.Score,
+		Signals:     make([]*proto.RiskSignal, 0, len(review.Risk.Signals)),
+		Status:      string(review.Status),
+		CreatedAt:   review.CreatedAt.Format(time.RFC3339),
+	}
+	res.UserId, _ = strconv.ParseInt(review.UserID, 10, 64)
+	res.ReviewedBy, _ = strconv.ParseInt(review.ReviewedBy, 10, 64)
+	if !review.ReviewedAt.IsZero() {
+		res.ReviewedAt = review.ReviewedAt.Format(time.RFC3339)
+	}
+	for _, signal := range review.Risk.Signals {
+		res.Signals = append(res.Signals, &proto.RiskSignal{Name: signal.Name, Score: signal.Score, Detail: signal.Detail})
+	}
+	return res
+}
+
 func (s *GameServer) GameTime(ctx context.Context, req *proto.GameTimeRequest) (*proto.GameTimeResponse, error) {
 	value := s.service.GameTime()
 	return &proto.GameTimeResponse{Success: true, Time: value}, nil
 }
 
 func (s *GameServer) MaxPlays(ctx context.Context, req *proto.MaxPlaysRequest) (*proto.MaxPlaysResponse, error) {
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
 	value := s.service.MaxPlays(user)
 	return &proto.MaxPlaysResponse{Success: true, Value: value}, nil
 }
 
 func (s *GameServer) PlayCount(ctx context.Context, req *proto.PlayCountRequest) (*proto.PlayCountResponse, error) {
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
 	value := s.service.PlayCount(user)
 	return &proto.PlayCountResponse{Success: true, Value: value}, nil
 }
 
 func (s *GameServer) PlaysLeft(ctx context.Context, req *proto.PlaysLeftRequest) (*proto.PlaysLeftResponse, error) {
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
 	value := s.service.PlaysLeft(user)
 	return &proto.PlaysLeftResponse{Success: true, Value: value}, nil
 }
 
+// caller returns the user making the request. With a session the caller is
+// the session's user and a claimed user with another ID is refused. Without a
+// session, which is only let through when sessions are not required, the
+// claimed user is trusted.
+func caller(ctx context.Context, claimed *proto.User) (domain.User, error) {
+	if session, ok := auth.FromContext(ctx); ok {
+		if claimed != nil && claimed.UserId != 0 && claimed.UserId != session.UserID {
+			return domain.User{}, status.Error(codes.PermissionDenied, "user does not match the session")
+		}
+		return domain.User{
+			UserId:       session.UserID,
+			Username:     session.Username,
+			FirstName:    session.FirstName,
+			LastName:     session.LastName,
+			LanguageCode: session.LanguageCode,
+			IsBot:        session.IsBot,
+		}, nil
+	}
+	if claimed == nil {
+		return domain.User{}, status.Error(codes.InvalidArgument, "missing user")
+	}
+	return domain.User{
+		UserId:       claimed.UserId,
+		Username:     claimed.Username,
+		FirstName:    claimed.FirstName,
+		LastName:     claimed.LastName,
+		LanguageCode: claimed.LanguageCode,
+		IsBot:        claimed.IsBot,
+	}, nil
+}
+
+// authorizeGame checks the session's user owns the game. Calls without a
+// session, only let through when sessions are not required, are not checked.
+func (s *GameServer) authorizeGame(ctx context.Context, gameID string) error {
+	session, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if err := s.service.CheckGameOwner(gameID, strconv.FormatInt(session.UserID, 10)); err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
+
+// toStatusError maps domain errors to gRPC status errors
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrGameExpired), errors.Is(err, domain.ErrGameEnded), errors.Is(err, domain.ErrNoMistakesLeft),
+		errors.Is(err, domain.ErrNoObjectsLeft),
+		errors.Is(err, domain.ErrDailyChallengePlayed), errors.Is(err, domain.ErrNoPlaysLeft),
+		errors.Is(err, domain.ErrReviewDecided), errors.Is(err, domain.ErrGameNotEnded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ports.ErrGameDataTampered), errors.Is(err, domain.ErrNotGameOwner),
+		errors.Is(err, domain.ErrUserBanned), errors.Is(err, domain.ErrNotModerator):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidGameQuery), errors.Is(err, domain.ErrUnknownGameMode),
+		errors.Is(err, domain.ErrUnknownModerationStatus), errors.Is(err, domain.ErrUnknownReviewStatus):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return err
+	}
+}
+
 type ReferralServer struct {
 	proto.UnimplementedReferralServiceServer
 	service     *app.ReferralService
@@ -185,16 +652,12 @@ func NewReferralServer(service *app.ReferralService, gameService *app.GameServic
 }
 
 func (s *ReferralServer) Referral(ctx context.Context, req *proto.ReferralRequest) (*proto.ReferralResponse, error) {
-	fmt.Println("req.User.UserId", req.User.UserId)
 	// Create a new user
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
 	}
+	fmt.Println("user.UserId", user.UserId)
 	_, addErr := s.gameService.AddUser(user)
 	if addErr != nil {
 		return nil, addErr
@@ -202,15 +665,15 @@ func (s *ReferralServer) Referral(ctx context.Context, req *proto.ReferralReques
 	fmt.Println("")
 	fmt.Println("")
 	fmt.Println("Referral")
-	fmt.Println(req.User)
+	fmt.Println(user)
 	fmt.Println("")
 	// Add the user to the service
-	createErr := s.service.Create(req.User.UserId)
+	createErr := s.service.Create(user.UserId)
 	if createErr != nil {
 		return nil, createErr
 	}
 	// debugging
-	fmt.Println(s.service.Get(strconv.FormatInt(req.User.UserId, 10)))
+	fmt.Println(s.service.Get(strconv.FormatInt(user.UserId, 10)))
 	//
 	return &proto.ReferralResponse{Success: true}, nil
 }
@@ -218,6 +681,14 @@ func (s *ReferralServer) Referral(ctx context.Context, req *proto.ReferralReques
 func (s *ReferralServer) AcceptReferral(ctx context.Context, req *proto.AcceptReferralRequest) (*proto.AcceptReferralResponse, error) {
 	// debugging
 	fmt.Println("AcceptReferral")
+	// Only the invited user can accept a referral
+	to, err := caller(ctx, req.To)
+	if err != nil {
+		return nil, err
+	}
+	if req.From == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing referring user")
+	}
 	id := strconv.FormatInt(req.From.UserId, 10)
 	fromUser, getUserError := s.gameService.GetUser(id)
 	if getUserError != nil {
@@ -239,40 +710,26 @@ func (s *ReferralServer) AcceptReferral(ctx context.Context, req *proto.AcceptRe
 		IsBot:        fromUser.IsBot,
 		// BonusGames:   fromUser.BonusGames,
 	}
-	to := domain.User{
-		UserId:       req.To.UserId,
-		Username:     req.To.Username,
-		FirstName:    req.To.FirstName,
-		LastName:     req.To.LastName,
-		LanguageCode: req.To.LanguageCode,
-		IsBot:        req.To.IsBot,
-		// BonusGames:   req.To.BonusGames,
-	}
-	success, err := s.service.Update(from, to, *s.gameService)
-	if !err {
+	success, ok := s.service.Update(from, to, *s.gameService)
+	if !ok {
 		return nil, errors.New("gRPC server accept referral update error")
 	}
 	return &proto.AcceptReferralResponse{Success: success}, nil
 }
 
 func (s *ReferralServer) ReferralStatistics(ctx context.Context, req *proto.ReferralStatisticsRequest) (*proto.ReferralStatisticsResponse, error) {
-	objects, err := s.service.Get(strconv.FormatInt(req.User.UserId, 10))
-	if !err {
-		fmt.Println("gRPC server referral statistics error", err)
+	user, err := caller(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	objects, ok := s.service.Get(strconv.FormatInt(user.UserId, 10))
+	if !ok {
+		fmt.Println("gRPC server referral statistics error", ok)
 	}
 	var count int64
 	if objects != nil {
 		count = int64(len(objects.Referrals))
 	}
-	user := domain.User{
-		UserId:       req.User.UserId,
-		Username:     req.User.Username,
-		FirstName:    req.User.FirstName,
-		LastName:     req.User.LastName,
-		LanguageCode: req.User.LanguageCode,
-		IsBot:        req.User.IsBot,
-		BonusGames:   req.User.BonusGames,
-	}
 	bCount, bErr := s.gameService.GetBonusGames(user)
 	if !bErr {
 		fmt.Println("gRPC server referral statistics get bonus games error", bErr)
@@ -280,3 +737,59 @@ func (s *ReferralServer) ReferralStatistics(ctx context.Context, req *proto.Refe
 	}
 	return &proto.ReferralStatisticsResponse{Success: true, Count: count, BonusCount: bCount}, nil
 }
+
+type TelegramServer struct {
+	proto.UnimplementedTelegramMiniAppServer
+	// Nil when no bot token is configured
+	validator *telegram.Validator
+	sessions  *auth.Issuer
+}
+
+func NewTelegramServer(validator *telegram.Validator, sessions *auth.Issuer) *TelegramServer {
+	return &TelegramServer{validator: validator, sessions: sessions}
+}
+
+// InitData validates the Mini App's initData and returns the Telegram user
+// and chat it was issued for, and a session token for the user
+func (s *TelegramServer) InitData(ctx context.Context, req *proto.InitDataRequest) (*proto.InitDataResponse, error) {
+	if s.validator == nil {
+		return &proto.InitDataResponse{Success: false, ErrorMessage: "init data validation is not configured"}, nil
+	}
+	data, err := s.validator.Validate(req.InitData)
+	if err != nil {
+		fmt.Println("InitData", err)
+		return &proto.InitDataResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	res := &proto.InitDataResponse{Success: true, Payload: data.StartParam}
+	if data.User != nil {
+		res.User = toProtoTelegramUser(data.User)
+		token, session, err := s.sessions.Issue(auth.Session{
+			UserID:       data.User.ID,
+			Username:     data.User.Username,
+			FirstName:    data.User.FirstName,
+			LastName:     data.User.LastName,
+			LanguageCode: data.User.LanguageCode,
+			IsBot:        data.User.IsBot,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to issue session: %v", err)
+		}
+		res.SessionToken = token
+		res.SessionExpiresAt = session.ExpiresAt.Format(time.RFC3339)
+	}
+	if data.Chat != nil {
+		res.Chat = &proto.Chat{ChatId: data.Chat.ID, ChatName: data.Chat.Title, ChatType: data.Chat.Type}
+	}
+	return res, nil
+}
+
+func toProtoTelegramUser(user *telegram.User) *proto.User {
+	return &proto.User{
+		UserId:       user.ID,
+		Username:     user.Username,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		LanguageCode: user.LanguageCode,
+		IsBot:        user.IsBot,
+	}
+}
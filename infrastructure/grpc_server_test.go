@@ -0,0 +1,342 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/app"
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/domain"
+	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/receipt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakePlayStream plays the client side of a PlaySession. Recv hands out the
+// requests returned by next, which sees the events sent so far.
+type fakePlayStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	next   func(sent []*proto.PlayEvent) (*proto.PlayRequest, error)
+	mutex  sync.Mutex
+	sent   []*proto.PlayEvent
+	signal chan struct{}
+}
+
+func newFakePlayStream(next func(sent []*proto.PlayEvent) (*proto.PlayRequest, error)) *fakePlayStream {
+	return &fakePlayStream{ctx: context.Background(), next: next, signal: make(chan struct{}, 1)}
+}
+
+func (f *fakePlayStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakePlayStream) Send(event *proto.PlayEvent) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.sent = append(f.sent, event)
+	select {
+	case f.signal <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (f *fakePlayStream) Recv() (*proto.PlayRequest, error) {
+	for {
+		f.mutex.Lock()
+		sent := append([]*proto.PlayEvent(nil), f.sent...)
+		f.mutex.Unlock()
+		if req, err := f.next(sent); req != nil || err != nil {
+			return req, err
+		}
+		<-f.signal
+	}
+}
+
+func (f *fakePlayStream) events() []*proto.PlayEvent {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]*proto.PlayEvent(nil), f.sent...)
+}
+
+func newTestGameServer(t *testing.T, rules ...domain.Rules) (*GameServer, *InMemoryGameRepository) {
+	book, err := domain.NewRuleBook(append([]domain.Rules{domain.DefaultRules, domain.DefaultEndlessRules}, rules...))
+	assert.NoError(t, err)
+	modes, err := domain.NewModeRegistry(domain.DefaultGameModes(time.Minute), book)
+	assert.NoError(t, err)
+	key, err := NewKey("test", []byte("0123456789abcdef0123456789abcdef"))
+	assert.NoError(t, err)
+	keys, err := NewKeyring("test", key)
+	assert.NoError(t, err)
+	signer := receipt.NewSigner("test", ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+
+	repo := NewInMemoryGameRepository()
+	service := app.NewGameService(repo, NewInMemoryGameEventRepository(), NewInMemoryUserRepository(), NewInMemoryLeaderboardRepository(), NewInMemoryReviewRepository(), NewEncrypter(keys), signer, book, modes, time.Hour)
+	return NewGameServer(service), repo
+}
+
+// saveTestGame stores game and the leaderboard StartGame would create for it
+func saveTestGame(t *testing.T, server *GameServer, repo *InMemoryGameRepository, game *domain.Game) {
+	assert.NoError(t, repo.SaveGame(game))
+	server.service.CreateLeaderboard(server.service.Leaderboard(game), false)
+}
+
+func TestPlaySession(t *testing.T) {
+	rules := domain.Rules{
+		Version:         "fast-1",
+		Mode:            domain.DefaultGameMode,
+		SpawnIntervalMs: 100,
+		Objects:         []domain.ObjectRule{{Type: "a", Weight: 1, Points: 1}},
+	}
+
+	t.Run("the game is finalized before game over when the mistakes run out", func(t *testing.T) {
+		server, repo := newTestGameServer(t, rules)
+		game := domain.NewGameWithSeed("1", 42, &rules, time.Minute)
+		game.MaxMistakes = 1
+		saveTestGame(t, server, repo, game)
+
+		// Start, then tap the first object twice before it spawned: the first
+		// tap is a miss that uses up the only mistake, the second is refused
+		// with no mistakes left and must not end the session
+		step := 0
+		stream := newFakePlayStream(func(sent []*proto.PlayEvent) (*proto.PlayRequest, error) {
+			switch step {
+			case 0:
+				step++
+				return &proto.PlayRequest{Action: &proto.PlayRequest_Start{Start: &proto.PlayStart{GameId: game.ID}}}, nil
+			case 1, 2:
+				for _, event := range sent {
+					if spawn := event.GetSpawn(); spawn != nil {
+						step++
+						spawnTime, _ := time.Parse(time.RFC3339Nano, spawn.SpawnTime)
+						tap := &proto.PlayTap{ObjectId: spawn.Id, Timestamp: spawnTime.Add(-time.Minute).Format(time.RFC3339)}
+						return &proto.PlayRequest{Action: &proto.PlayRequest_Tap{Tap: tap}}, nil
+					}
+				}
+				// Wait for the first spawn
+				return nil, nil
+			default:
+				return nil, io.EOF
+			}
+		})
+
+		err := server.PlaySession(stream)
+
+		assert.NoError(t, err)
+		events := stream.events()
+		assert.NotEmpty(t, events)
+		over := events[len(events)-1].GetGameOver()
+		if assert.NotNil(t, over) {
+			assert.Equal(t, string(domain.GameStatusEnded), over.Status)
+			assert.True(t, over.Verified)
+			assert.NotEmpty(t, over.Receipt)
+		}
+		stored, err := repo.GetGame(game.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GameStatusEnded, stored.Status)
+		assert.Equal(t, int32(1), stored.Mistakes)
+		assert.True(t, stored.IsFinalized())
+	})
+
+	t.Run("the game is finalized before game over when it runs out", func(t *testing.T) {
+		server, repo := newTestGameServer(t, rules)
+		game := domain.NewGameWithSeed("1", 42, &rules, 250*time.Millisecond)
+		saveTestGame(t, server, repo, game)
+
+		started := false
+		stream := newFakePlayStream(func(sent []*proto.PlayEvent) (*proto.PlayRequest, error) {
+			if !started {
+				started = true
+				return &proto.PlayRequest{Action: &proto.PlayRequest_Start{Start: &proto.PlayStart{GameId: game.ID}}}, nil
+			}
+			return nil, io.EOF
+		})
+
+		err := server.PlaySession(stream)
+
+		assert.NoError(t, err)
+		events := stream.events()
+		assert.NotEmpty(t, events)
+		over := events[len(events)-1].GetGameOver()
+		if assert.NotNil(t, over) {
+			assert.Equal(t, string(domain.GameStatusEnded), over.Status)
+			assert.True(t, over.Verified)
+			assert.NotEmpty(t, over.Receipt)
+		}
+		stored, err := repo.GetGame(game.ID)
+		assert.NoError(t, err)
+		if assert.True(t, stored.IsFinalized()) {
+			assert.Equal(t, over.Receipt, stored.Result.Receipt)
+		}
+	})
+}
+
+func TestLeaderboard(t *testing.T) {
+	t.Setenv("MODERATOR_USER_IDS", "7")
+	server, _ := newTestGameServer(t)
+	cheater := domain.User{UserId: 1, Username: "cheater"}
+	player := domain.User{UserId: 2, Username: "player"}
+	name, err := server.service.LeaderboardName("", time.Now())
+	assert.NoError(t, err)
+	server.service.CreateLeaderboard(name, false)
+	for _, entry := range []domain.GameEntry{{User: cheater, Score: 90}, {User: player, Score: 10}} {
+		_, err := server.service.AddUser(entry.User)
+		assert.NoError(t, err)
+		_, err = server.service.AddToLeaderboard(name, entry.User, entry.Score)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, server.service.ModerateUser("7", "1", domain.ModerationQuarantined))
+
+	t.Run("a quarantined user with a session sees their own score", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Session{UserID: 1, Username: "cheater"})
+
+		res, err := server.Leaderboard(ctx, &proto.LeaderboardRequest{})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"Username":"cheater","Score":90},{"Username":"player","Score":10}]`, res.Table)
+	})
+
+	t.Run("claiming to be a quarantined user shows nothing of theirs", func(t *testing.T) {
+		res, err := server.Leaderboard(context.Background(), &proto.LeaderboardRequest{User: &proto.User{UserId: 1}})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"Username":"player","Score":10}]`, res.Table)
+		assert.JSONEq(t, `[]`, res.UserScore)
+	})
+}
+
+func TestGameOwnership(t *testing.T) {
+	issuer := newTestIssuer(t)
+	// unary runs handler behind an AuthInterceptor, as the server does
+	unary := func(required bool, ctx context.Context, method string, handler grpc.UnaryHandler) error {
+		interceptor := NewAuthInterceptor(issuer, required, PublicMethods...)
+		_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	tokenFor := func(userID int64) context.Context {
+		token, _, err := issuer.Issue(auth.Session{UserID: userID})
+		assert.NoError(t, err)
+		return withToken(token)
+	}
+	newGame := func(t *testing.T) (*GameServer, *InMemoryGameRepository, *domain.Game) {
+		server, repo := newTestGameServer(t)
+		game := domain.NewGameWithSeed("1", 42, &domain.DefaultRules, time.Minute)
+		saveTestGame(t, server, repo, game)
+		return server, repo, game
+	}
+
+	t.Run("another user's session is refused on every game RPC", func(t *testing.T) {
+		server, repo, game := newGame(t)
+		calls := map[string]grpc.UnaryHandler{
+			proto.GameService_Spawn_FullMethodName: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return server.Spawn(ctx, &proto.SpawnRequest{GameId: game.ID})
+			},
+			proto.GameService_Tap_FullMethodName: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return server.Tap(ctx, &proto.TapRequest{GameId: game.ID, ObjectId: "any", Timestamp: time.Now().Format(time.RFC3339)})
+			},
+			proto.GameService_EndGame_FullMethodName: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return server.EndGame(ctx, &proto.EndGameRequest{GameId: game.ID})
+			},
+			proto.GameService_GetGameReplay_FullMethodName: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return server.GetGameReplay(ctx, &proto.GameReplayRequest{GameId: game.ID})
+			},
+		}
+
+		for method, handler := range calls {
+			for _, required := range []bool{true, false} {
+				err := unary(required, tokenFor(2), method, handler)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err), method)
+			}
+		}
+
+		stored, err := repo.GetGame(game.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, stored.ObjectSeq)
+		assert.True(t, stored.IsOpen())
+		assert.Nil(t, stored.Result)
+	})
+
+	t.Run("another user's session can't open a play session", func(t *testing.T) {
+		server, repo, game := newGame(t)
+		interceptor := NewAuthInterceptor(issuer, true, PublicMethods...)
+		started := false
+		stream := newFakePlayStream(func(sent []*proto.PlayEvent) (*proto.PlayRequest, error) {
+			if started {
+				return nil, io.EOF
+			}
+			started = true
+			return &proto.PlayRequest{Action: &proto.PlayRequest_Start{Start: &proto.PlayStart{GameId: game.ID}}}, nil
+		})
+		stream.ctx = tokenFor(2)
+		info := &grpc.StreamServerInfo{FullMethod: proto.GameService_PlaySession_FullMethodName}
+
+		err := interceptor.Stream()(nil, stream, info, func(_ interface{}, wrapped grpc.ServerStream) error {
+			return server.PlaySession(&grpcPlayStream{ServerStream: wrapped, fake: stream})
+		})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, stream.events())
+		stored, err := repo.GetGame(game.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, stored.ObjectSeq)
+	})
+
+	t.Run("the owner's session is let through", func(t *testing.T) {
+		server, _, game := newGame(t)
+
+		err := unary(true, tokenFor(1), proto.GameService_Spawn_FullMethodName, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return server.Spawn(ctx, &proto.SpawnRequest{GameId: game.ID})
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("without a session and REQUIRE_AUTH=false ownership is not checked", func(t *testing.T) {
+		server, repo, game := newGame(t)
+
+		// Any caller can play the game
+		err := unary(false, context.Background(), proto.GameService_Spawn_FullMethodName, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return server.Spawn(ctx, &proto.SpawnRequest{GameId: game.ID})
+		})
+		assert.NoError(t, err)
+
+		// and end it claiming to be someone else, the score stays the owner's
+		var ended *proto.EndGameResponse
+		err = unary(false, context.Background(), proto.GameService_EndGame_FullMethodName, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			var err error
+			ended, err = server.EndGame(ctx, &proto.EndGameRequest{GameId: game.ID, User: &proto.User{UserId: 2}})
+			return ended, err
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, ended)
+		stored, err := repo.GetGame(game.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", stored.UserID)
+		assert.Equal(t, domain.GameStatusEnded, stored.Status)
+
+		// Sessions are still required when REQUIRE_AUTH is left on
+		err = unary(true, context.Background(), proto.GameService_Spawn_FullMethodName, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return server.Spawn(ctx, &proto.SpawnRequest{GameId: game.ID})
+		})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// grpcPlayStream is the PlaySession stream the interceptor hands to the
+// handler, receiving and sending through the fake client
+type grpcPlayStream struct {
+	grpc.ServerStream
+	fake *fakePlayStream
+}
+
+func (s *grpcPlayStream) Send(event *proto.PlayEvent) error { return s.fake.Send(event) }
+
+func (s *grpcPlayStream) Recv() (*proto.PlayRequest, error) { return s.fake.Recv() }
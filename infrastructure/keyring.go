@@ -0,0 +1,207 @@
+package infrastructure
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// MinKeySecretSize is the shortest master secret a keyring accepts
+const MinKeySecretSize = 32
+
+var (
+	ErrInvalidKeyring = errors.New("invalid keyring")
+	ErrUnknownKey     = errors.New("unknown key")
+)
+
+// Key IDs are written into every envelope so they are kept to a safe alphabet
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// KeyringFile is the JSON layout of a keyring file or the ENCRYPTION_KEYS
+// variable. Secrets are base64 encoded.
+type KeyringFile struct {
+	// ID of the key that encrypts new data
+	Current string          `json:"current"`
+	Keys    []KeyringSecret `json:"keys"`
+}
+
+type KeyringSecret struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+// Key holds the keys derived from one master secret. Encryption and MAC
+// keys are derived separately so neither is used for both purposes.
+type Key struct {
+	ID     string
+	EncKey []byte
+	MacKey []byte
+}
+
+// NewKey derives the encryption and MAC keys of the key id from secret
+func NewKey(id string, secret []byte) (*Key, error) {
+	if !keyIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("%w: key id %q must be 1-32 letters, digits, - or _", ErrInvalidKeyring, id)
+	}
+	if len(secret) < MinKeySecretSize {
+		return nil, fmt.Errorf("%w: key %s secret is shorter than %d bytes", ErrInvalidKeyring, id, MinKeySecretSize)
+	}
+	encKey, err := deriveKey(secret, id, "qiba game data encryption")
+	if err != nil {
+		return nil, err
+	}
+	macKey, err := deriveKey(secret, id, "qiba game data mac")
+	if err != nil {
+		return nil, err
+	}
+	return &Key{ID: id, EncKey: encKey, MacKey: macKey}, nil
+}
+
+func deriveKey(secret []byte, id string, purpose string) ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, []byte(id), []byte(purpose)), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Keyring holds every key that can still open game data and which of them
+// seals new data. It can be swapped while in use so keys rotate without a
+// restart: add a key and make it current, then remove the old key once the
+// data it sealed is no longer needed.
+type Keyring struct {
+	mu      sync.RWMutex
+	current *Key
+	keys    map[string]*Key
+	// Where Reload reads the keyring from, nil when it was built in code
+	source func() ([]byte, error)
+}
+
+// NewKeyring builds a keyring whose current key is current
+func NewKeyring(current string, keys ...*Key) (*Keyring, error) {
+	k := &Keyring{}
+	if err := k.set(current, keys); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// LoadKeyringFile reads the keyring from the JSON file at path. Reload
+// reads the file again.
+func LoadKeyringFile(path string) (*Keyring, error) {
+	return loadKeyring(func() ([]byte, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keyring file: %w", err)
+		}
+		return data, nil
+	})
+}
+
+// LoadKeyringEnv reads the keyring JSON from the environment variable name.
+// Reload reads the variable again.
+func LoadKeyringEnv(name string) (*Keyring, error) {
+	return loadKeyring(func() ([]byte, error) {
+		data := os.Getenv(name)
+		if data == "" {
+			return nil, fmt.Errorf("%w: %s is not set", ErrInvalidKeyring, name)
+		}
+		return []byte(data), nil
+	})
+}
+
+func loadKeyring(source func() ([]byte, error)) (*Keyring, error) {
+	k := &Keyring{source: source}
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Reload replaces the keys with the ones at the keyring's source. The keys
+// are left as they were if the source can't be read or is invalid.
+func (k *Keyring) Reload() error {
+	if k.source == nil {
+		return fmt.Errorf("%w: keyring has no source to reload from", ErrInvalidKeyring)
+	}
+	data, err := k.source()
+	if err != nil {
+		return err
+	}
+	var file KeyringFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidKeyring, err)
+	}
+	keys := make([]*Key, 0, len(file.Keys))
+	for _, s := range file.Keys {
+		secret, err := base64.StdEncoding.DecodeString(s.Secret)
+		if err != nil {
+			return fmt.Errorf("%w: key %s secret is not base64", ErrInvalidKeyring, s.ID)
+		}
+		key, err := NewKey(s.ID, secret)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	return k.set(file.Current, keys)
+}
+
+func (k *Keyring) set(current string, keys []*Key) error {
+	byID := make(map[string]*Key, len(keys))
+	for _, key := range keys {
+		if _, ok := byID[key.ID]; ok {
+			return fmt.Errorf("%w: duplicate key id %s", ErrInvalidKeyring, key.ID)
+		}
+		byID[key.ID] = key
+	}
+	currentKey, ok := byID[current]
+	if !ok {
+		return fmt.Errorf("%w: current key %q is not in the keyring", ErrInvalidKeyring, current)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.current = currentKey
+	k.keys = byID
+	return nil
+}
+
+// Current is the key that seals new data
+func (k *Keyring) Current() *Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.current
+}
+
+// Key finds a key by ID, including keys that are no longer current
+func (k *Keyring) Key(id string) (*Key, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+	return key, nil
+}
+
+// IDs lists the IDs of the keys in the keyring in order
+func (k *Keyring) IDs() []string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSecret is a valid master secret made of fill
+func testSecret(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, MinKeySecretSize)
+}
+
+// writeKeyring writes a keyring file with a key per ID, each with its own
+// secret, and returns its path
+func writeKeyring(t *testing.T, path, current string, ids ...string) string {
+	file := KeyringFile{Current: current}
+	for i, id := range ids {
+		file.Keys = append(file.Keys, KeyringSecret{ID: id, Secret: base64.StdEncoding.EncodeToString(testSecret(byte('a' + i)))})
+	}
+	data, err := json.Marshal(file)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewKey(t *testing.T) {
+	_, err := NewKey("k1", testSecret('a'))
+	assert.NoError(t, err)
+
+	for _, id := range []string{"", "has.dot", "has space", "0123456789abcdef0123456789abcdef0"} {
+		_, err := NewKey(id, testSecret('a'))
+		assert.ErrorIs(t, err, ErrInvalidKeyring, id)
+	}
+
+	_, err = NewKey("k1", testSecret('a')[:MinKeySecretSize-1])
+	assert.ErrorIs(t, err, ErrInvalidKeyring)
+}
+
+func TestNewKeyring(t *testing.T) {
+	k1, err := NewKey("k1", testSecret('a'))
+	assert.NoError(t, err)
+	k2, err := NewKey("k2", testSecret('b'))
+	assert.NoError(t, err)
+
+	keys, err := NewKeyring("k2", k1, k2)
+	assert.NoError(t, err)
+	assert.Same(t, k2, keys.Current())
+	assert.Equal(t, []string{"k1", "k2"}, keys.IDs())
+	found, err := keys.Key("k1")
+	assert.NoError(t, err)
+	assert.Same(t, k1, found)
+	_, err = keys.Key("k3")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	_, err = NewKeyring("k3", k1, k2)
+	assert.ErrorIs(t, err, ErrInvalidKeyring)
+	_, err = NewKeyring("k1", k1, k1)
+	assert.ErrorIs(t, err, ErrInvalidKeyring)
+}
+
+func TestKeyringReload(t *testing.T) {
+	t.Run("reloading picks up a rotated key", func(t *testing.T) {
+		path := writeKeyring(t, filepath.Join(t.TempDir(), "keys.json"), "k1", "k1")
+		keys, err := LoadKeyringFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "k1", keys.Current().ID)
+
+		writeKeyring(t, path, "k2", "k1", "k2")
+		assert.NoError(t, keys.Reload())
+
+		assert.Equal(t, "k2", keys.Current().ID)
+		assert.Equal(t, []string{"k1", "k2"}, keys.IDs())
+	})
+
+	t.Run("a bad keyring file keeps the old keys", func(t *testing.T) {
+		path := writeKeyring(t, filepath.Join(t.TempDir(), "keys.json"), "k1", "k1", "k2")
+		keys, err := LoadKeyringFile(path)
+		assert.NoError(t, err)
+		before := keys.Current()
+
+		bad := map[string]string{
+			"malformed JSON":     `{"current": "k1", "keys": [`,
+			"unknown current":    `{"current": "k3", "keys": [{"id": "k1", "secret": "` + base64.StdEncoding.EncodeToString(testSecret('a')) + `"}]}`,
+			"secret not base64":  `{"current": "k1", "keys": [{"id": "k1", "secret": "not base64!"}]}`,
+			"secret too short":   `{"current": "k1", "keys": [{"id": "k1", "secret": "c2hvcnQ="}]}`,
+			"duplicate key id":   `{"current": "k1", "keys": [{"id": "k1", "secret": "` + base64.StdEncoding.EncodeToString(testSecret('a')) + `"}, {"id": "k1", "secret": "` + base64.StdEncoding.EncodeToString(testSecret('b')) + `"}]}`,
+			"no keys at all":     `{}`,
+			"invalid key id":     `{"current": "k.1", "keys": [{"id": "k.1", "secret": "` + base64.StdEncoding.EncodeToString(testSecret('a')) + `"}]}`,
+			"empty current name": `{"current": "", "keys": [{"id": "k1", "secret": "` + base64.StdEncoding.EncodeToString(testSecret('a')) + `"}]}`,
+		}
+		for name, data := range bad {
+			assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+			assert.ErrorIs(t, keys.Reload(), ErrInvalidKeyring, name)
+			assert.Same(t, before, keys.Current(), name)
+			assert.Equal(t, []string{"k1", "k2"}, keys.IDs(), name)
+		}
+
+		assert.NoError(t, os.Remove(path))
+		assert.Error(t, keys.Reload())
+		assert.Same(t, before, keys.Current())
+	})
+
+	t.Run("a keyring built in code has nothing to reload", func(t *testing.T) {
+		k1, err := NewKey("k1", testSecret('a'))
+		assert.NoError(t, err)
+		keys, err := NewKeyring("k1", k1)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, keys.Reload(), ErrInvalidKeyring)
+		assert.Same(t, k1, keys.Current())
+	})
+}
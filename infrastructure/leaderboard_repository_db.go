@@ -49,14 +49,18 @@ func (repo *InMemoryLeaderboardRepository) UpdateLeaderboard(table *domain.Table
 	return nil
 }
 
-// UpdateGame updates an existing table in the in-memory map
+// AddEntryToLeaderboard adds an entry to an existing table, once per game
 func (repo *InMemoryLeaderboardRepository) AddEntryToLeaderboard(table *domain.Table, entry *domain.GameEntry) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
-	_, exists := repo.store[table.ID]
+	stored, exists := repo.store[table.ID]
 	if !exists {
 		return errors.New("table not found")
 	}
+	// The game's score is already on the board
+	if stored.HasGame(entry.GameID) {
+		return nil
+	}
 	table.Entries = append(table.Entries, *entry)
 	repo.store[table.ID] = table
 	return nil
@@ -31,7 +31,7 @@ func NewMongoDbLeaderboardRepository() *MongoDbLeaderboardRepository {
 	}
 
 	// Send a ping to confirm a successful connection
-	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{"ping", 1}}).Err(); err != nil {
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
 		panic(err)
 	}
 	fmt.Println("Leaderboard repository - Pinged your deployment. You successfully connected to MongoDB!")
@@ -106,11 +106,16 @@ func (repo *MongoDbLeaderboardRepository) UpdateLeaderboard(table *domain.Table)
 	return nil
 }
 
-// AddEntryToLeaderboard adds a new entry to an existing leaderboard
+// AddEntryToLeaderboard adds a new entry to an existing leaderboard. An
+// entry for a game already on the board is not added again.
 func (repo *MongoDbLeaderboardRepository) AddEntryToLeaderboard(table *domain.Table, entry *domain.GameEntry) error {
+	filter := bson.M{"ID": table.ID}
+	if entry.GameID != "" {
+		filter["Entries.GameID"] = bson.M{"$ne": entry.GameID}
+	}
 	result, err := repo.collection.UpdateOne(
 		context.TODO(),
-		bson.M{"ID": table.ID},
+		filter,
 		bson.M{
 			"$push": bson.M{
 				"Entries": &entry,
@@ -121,6 +126,16 @@ func (repo *MongoDbLeaderboardRepository) AddEntryToLeaderboard(table *domain.Ta
 		return err
 	}
 	if result.MatchedCount == 0 {
+		if entry.GameID != "" {
+			// Either the table is missing or the game is already on it
+			count, err := repo.collection.CountDocuments(context.TODO(), bson.M{"ID": table.ID})
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+		}
 		return errors.New("table not found")
 	}
 	return nil
@@ -0,0 +1,242 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimitedServices are the services whose calls are rate limited
+var RateLimitedServices = []string{
+	proto.GameService_ServiceDesc.ServiceName,
+	proto.ReferralService_ServiceDesc.ServiceName,
+}
+
+// RateLimits configures the rate limit of each RPC. A limit applies to each
+// user, and PeerFactor times the limit to each peer address, as several
+// users can share one address.
+type RateLimits struct {
+	// Limit of the methods not in Methods
+	Default ratelimit.Limit `json:"default"`
+	// Limits by full method name, e.g. "/qiba.GameService/Tap"
+	Methods    map[string]ratelimit.Limit `json:"methods"`
+	PeerFactor float64                    `json:"peer_factor"`
+	// Take the peer address from the last X-Forwarded-For entry, for servers
+	// behind a proxy. Don't set it otherwise, clients can forge the header.
+	TrustForwardedFor bool `json:"trust_forwarded_for"`
+}
+
+// DefaultRateLimits allow a fast player through but stop scripted clients
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		Default: ratelimit.Limit{Rate: 5, Burst: 20},
+		Methods: map[string]ratelimit.Limit{
+			proto.GameService_StartGame_FullMethodName: {Rate: 0.2, Burst: 5},
+			proto.GameService_Spawn_FullMethodName:     {Rate: 10, Burst: 20},
+			proto.GameService_Tap_FullMethodName:       {Rate: 15, Burst: 30},
+		},
+		PeerFactor: 10,
+	}
+}
+
+// ParseRateLimits reads JSON rate limits. Anything left out keeps its
+// DefaultRateLimits value, a limit with a zero rate turns the limit off.
+func ParseRateLimits(data []byte) (RateLimits, error) {
+	limits := DefaultRateLimits()
+	var parsed RateLimits
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return RateLimits{}, fmt.Errorf("failed to parse rate limits: %w", err)
+	}
+	if parsed.Default != (ratelimit.Limit{}) {
+		limits.Default = parsed.Default
+	}
+	for method, limit := range parsed.Methods {
+		limits.Methods[method] = limit
+	}
+	if parsed.PeerFactor > 0 {
+		limits.PeerFactor = parsed.PeerFactor
+	}
+	limits.TrustForwardedFor = parsed.TrustForwardedFor
+	return limits, nil
+}
+
+// For returns the per user limit of method
+func (l RateLimits) For(method string) ratelimit.Limit {
+	if limit, ok := l.Methods[method]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// streamMessageMethods maps a stream to the method whose limit each message
+// the client sends on it counts against, as the message does the same
+var streamMessageMethods = map[string]string{
+	proto.GameService_PlaySession_FullMethodName: proto.GameService_Tap_FullMethodName,
+}
+
+// RateLimitInterceptor refuses calls to the rate limited services once the
+// caller's user or peer address has used up its tokens. Refused calls fail
+// with RESOURCE_EXHAUSTED and a "retry-after" header holding the seconds to
+// wait. Streams are limited when they are opened, and the taps a PlaySession
+// receives count against the Tap limit.
+type RateLimitInterceptor struct {
+	store    ratelimit.Store
+	limits   RateLimits
+	services map[string]bool
+	now      func() time.Time
+}
+
+func NewRateLimitInterceptor(store ratelimit.Store, limits RateLimits, services ...string) *RateLimitInterceptor {
+	limited := make(map[string]bool, len(services))
+	for _, service := range services {
+		limited[service] = true
+	}
+	return &RateLimitInterceptor{store: store, limits: limits, services: limited, now: time.Now}
+}
+
+// Unary must run after the AuthInterceptor, so the session is known
+func (r *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		retryAfter, err := r.allow(ctx, info.FullMethod)
+		if err != nil {
+			grpc.SetHeader(ctx, retryAfterHeader(retryAfter))
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream must run after the AuthInterceptor, so the session is known
+func (r *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		retryAfter, err := r.allow(stream.Context(), info.FullMethod)
+		if err != nil {
+			stream.SetHeader(retryAfterHeader(retryAfter))
+			return err
+		}
+		if method, ok := streamMessageMethods[info.FullMethod]; ok {
+			stream = &rateLimitedStream{ServerStream: stream, limiter: r, method: method}
+		}
+		return handler(srv, stream)
+	}
+}
+
+// rateLimitedStream charges the taps the client sends to method's limit
+type rateLimitedStream struct {
+	grpc.ServerStream
+	limiter *RateLimitInterceptor
+	method  string
+}
+
+// RecvMsg refuses a tap over the limit, which ends the stream
+func (s *rateLimitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if tap, ok := m.(interface{ GetTap() *proto.PlayTap }); !ok || tap.GetTap() == nil {
+		return nil
+	}
+	retryAfter, err := s.limiter.allow(s.Context(), s.method)
+	if err != nil {
+		// The headers went out with the first message, so the wait goes in
+		// the trailer
+		s.SetTrailer(retryAfterHeader(retryAfter))
+		return err
+	}
+	return nil
+}
+
+// allow takes a token from the session user's and the peer's bucket for
+// method, or from neither when one of them is empty. Calls without a session
+// only have a peer bucket.
+func (r *RateLimitInterceptor) allow(ctx context.Context, method string) (time.Duration, error) {
+	if !r.services[serviceName(method)] {
+		return 0, nil
+	}
+	limit := r.limits.For(method)
+	if limit.Unlimited() {
+		return 0, nil
+	}
+	var buckets []ratelimit.Bucket
+	if user := callerID(ctx); user != "" {
+		buckets = append(buckets, ratelimit.Bucket{Key: "user:" + user + ":" + method, Limit: limit})
+	}
+	if address := r.peerAddress(ctx); address != "" {
+		peerLimit := ratelimit.Limit{Rate: limit.Rate * r.limits.PeerFactor, Burst: int(math.Ceil(float64(limit.Burst) * r.limits.PeerFactor))}
+		buckets = append(buckets, ratelimit.Bucket{Key: "peer:" + address + ":" + method, Limit: peerLimit})
+	}
+	if len(buckets) == 0 {
+		return 0, nil
+	}
+	ok, retryAfter, err := r.store.Take(buckets, r.now())
+	if err != nil {
+		// A store that can't be reached must not take the game down
+		fmt.Println("RateLimitInterceptor", method, err)
+		return 0, nil
+	}
+	if !ok {
+		return retryAfter, status.Errorf(codes.ResourceExhausted, "too many %s calls, retry in %s", method, retryAfter.Round(time.Millisecond))
+	}
+	return 0, nil
+}
+
+// peerAddress returns the caller's IP address without its port
+func (r *RateLimitInterceptor) peerAddress(ctx context.Context) string {
+	if r.limits.TrustForwardedFor {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+				hops := strings.Split(forwarded[len(forwarded)-1], ",")
+				if address := strings.TrimSpace(hops[len(hops)-1]); address != "" {
+					return address
+				}
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	address := p.Addr.String()
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+// callerID returns the session's user. The user a request claims is never
+// used, anyone could claim another user's ID and drain their bucket.
+func callerID(ctx context.Context) string {
+	if session, ok := auth.FromContext(ctx); ok {
+		return strconv.FormatInt(session.UserID, 10)
+	}
+	return ""
+}
+
+// serviceName returns "qiba.GameService" for "/qiba.GameService/Tap"
+func serviceName(method string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return service
+}
+
+// retryAfterHeader holds the whole seconds to wait, at least one
+func retryAfterHeader(retryAfter time.Duration) metadata.MD {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10))
+}
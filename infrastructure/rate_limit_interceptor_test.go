@@ -0,0 +1,256 @@
+package infrastructure
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTransportStream keeps the headers a unary handler sets
+type fakeTransportStream struct {
+	method string
+	header metadata.MD
+}
+
+func (f *fakeTransportStream) Method() string { return f.method }
+
+func (f *fakeTransportStream) SetHeader(md metadata.MD) error {
+	f.header = metadata.Join(f.header, md)
+	return nil
+}
+
+func (f *fakeTransportStream) SendHeader(md metadata.MD) error { return f.SetHeader(md) }
+
+func (f *fakeTransportStream) SetTrailer(md metadata.MD) error { return nil }
+
+// fakeServerStream hands out requests and keeps the header and trailer
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*proto.PlayRequest
+	header   metadata.MD
+	trailer  metadata.MD
+}
+
+func (f *fakeServerStream) Context() context.Context { return f.ctx }
+
+func (f *fakeServerStream) SetHeader(md metadata.MD) error {
+	f.header = metadata.Join(f.header, md)
+	return nil
+}
+
+func (f *fakeServerStream) SetTrailer(md metadata.MD) {
+	f.trailer = metadata.Join(f.trailer, md)
+}
+
+func (f *fakeServerStream) RecvMsg(m interface{}) error {
+	next := f.requests[0]
+	f.requests = f.requests[1:]
+	m.(*proto.PlayRequest).Action = next.Action
+	return nil
+}
+
+// fakeClock is a clock tests move by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestRateLimitInterceptor(limits RateLimits) (*RateLimitInterceptor, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	interceptor := NewRateLimitInterceptor(ratelimit.NewMemoryStore(), limits, RateLimitedServices...)
+	interceptor.now = clock.Now
+	return interceptor, clock
+}
+
+// callerContext is a call from userID with a session, made from address
+func callerContext(userID int64, address string) context.Context {
+	ctx := auth.NewContext(context.Background(), &auth.Session{UserID: userID})
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 50000}})
+}
+
+func TestRateLimitInterceptorUnary(t *testing.T) {
+	limits := RateLimits{
+		Default:    ratelimit.Limit{Rate: 1, Burst: 2},
+		Methods:    map[string]ratelimit.Limit{},
+		PeerFactor: 1,
+	}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(interceptor *RateLimitInterceptor, ctx context.Context, method string) (*fakeTransportStream, error) {
+		transport := &fakeTransportStream{method: method}
+		ctx = grpc.NewContextWithServerTransportStream(ctx, transport)
+		_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, ok)
+		return transport, err
+	}
+	method := proto.GameService_GameTime_FullMethodName
+
+	t.Run("a burst is allowed, then calls wait for the refill", func(t *testing.T) {
+		interceptor, clock := newTestRateLimitInterceptor(limits)
+		ctx := callerContext(1, "10.0.0.1")
+		for i := 0; i < 2; i++ {
+			_, err := call(interceptor, ctx, method)
+			assert.NoError(t, err, "call %d", i)
+		}
+
+		transport, err := call(interceptor, ctx, method)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"1"}, transport.header.Get("retry-after"))
+
+		clock.Advance(time.Second)
+		_, err = call(interceptor, ctx, method)
+		assert.NoError(t, err)
+		_, err = call(interceptor, ctx, method)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("users and methods have their own buckets", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		for i := 0; i < 2; i++ {
+			_, err := call(interceptor, callerContext(1, "10.0.0.1"), method)
+			assert.NoError(t, err)
+		}
+
+		_, err := call(interceptor, callerContext(1, "10.0.0.1"), method)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = call(interceptor, callerContext(1, "10.0.0.1"), proto.GameService_MaxPlays_FullMethodName)
+		assert.NoError(t, err)
+		_, err = call(interceptor, callerContext(2, "10.0.0.2"), method)
+		assert.NoError(t, err)
+	})
+
+	t.Run("a refused call takes no token from the other bucket", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		// User 1 empties the shared address's bucket
+		for i := 0; i < 2; i++ {
+			_, err := call(interceptor, callerContext(1, "10.0.0.1"), method)
+			assert.NoError(t, err)
+		}
+		for i := 0; i < 3; i++ {
+			_, err := call(interceptor, callerContext(2, "10.0.0.1"), method)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		}
+
+		// User 2's own bucket is still full
+		for i := 0; i < 2; i++ {
+			_, err := call(interceptor, callerContext(2, "10.0.0.2"), method)
+			assert.NoError(t, err, "call %d", i)
+		}
+	})
+
+	t.Run("a claimed user without a session does not use that user's bucket", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		// Without a session, e.g. with REQUIRE_AUTH=false, a caller claims user 1
+		claimed := &proto.StartGameRequest{User: &proto.User{UserId: 1}}
+		anonymous := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.9"), Port: 50000}})
+		for i := 0; i < 5; i++ {
+			ctx := grpc.NewContextWithServerTransportStream(anonymous, &fakeTransportStream{method: method})
+			_, err := interceptor.Unary()(ctx, claimed, &grpc.UnaryServerInfo{FullMethod: method}, ok)
+			if i < 2 {
+				assert.NoError(t, err, "call %d", i)
+			} else {
+				assert.Equal(t, codes.ResourceExhausted, status.Code(err), "call %d", i)
+			}
+		}
+
+		// Only the caller's peer ran out, user 1's bucket is still full
+		for i := 0; i < 2; i++ {
+			_, err := call(interceptor, callerContext(1, "10.0.0.1"), method)
+			assert.NoError(t, err, "call %d", i)
+		}
+	})
+
+	t.Run("other services are not limited", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		for i := 0; i < 10; i++ {
+			_, err := call(interceptor, callerContext(1, "10.0.0.1"), proto.TelegramMiniApp_InitData_FullMethodName)
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestRateLimitInterceptorStream(t *testing.T) {
+	limits := RateLimits{
+		Default: ratelimit.Limit{Rate: 1, Burst: 2},
+		Methods: map[string]ratelimit.Limit{
+			proto.GameService_PlaySession_FullMethodName: {Rate: 1, Burst: 1},
+			proto.GameService_Tap_FullMethodName:         {Rate: 1, Burst: 2},
+		},
+		PeerFactor: 10,
+	}
+	tap := &proto.PlayRequest{Action: &proto.PlayRequest_Tap{Tap: &proto.PlayTap{ObjectId: "a"}}}
+	start := &proto.PlayRequest{Action: &proto.PlayRequest_Start{Start: &proto.PlayStart{GameId: "game1"}}}
+	info := &grpc.StreamServerInfo{FullMethod: proto.GameService_PlaySession_FullMethodName, IsClientStream: true, IsServerStream: true}
+	// receive reads count messages and returns the first error
+	receive := func(count int) grpc.StreamHandler {
+		return func(srv interface{}, stream grpc.ServerStream) error {
+			for i := 0; i < count; i++ {
+				if err := stream.RecvMsg(new(proto.PlayRequest)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	t.Run("every tap counts against the Tap limit", func(t *testing.T) {
+		interceptor, clock := newTestRateLimitInterceptor(limits)
+		stream := &fakeServerStream{ctx: callerContext(1, "10.0.0.1"), requests: []*proto.PlayRequest{start, tap, tap, tap, tap}}
+
+		err := interceptor.Stream()(nil, stream, info, receive(5))
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"1"}, stream.trailer.Get("retry-after"))
+		// The start message and two taps got through
+		assert.Len(t, stream.requests, 1)
+
+		clock.Advance(time.Second)
+		stream = &fakeServerStream{ctx: callerContext(1, "10.0.0.1"), requests: []*proto.PlayRequest{start, tap}}
+		err = interceptor.Stream()(nil, stream, info, receive(2))
+		assert.NoError(t, err)
+	})
+
+	t.Run("opening a stream counts against its own limit", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		err := interceptor.Stream()(nil, &fakeServerStream{ctx: callerContext(1, "10.0.0.1"), requests: []*proto.PlayRequest{start}}, info, receive(1))
+		assert.NoError(t, err)
+
+		stream := &fakeServerStream{ctx: callerContext(1, "10.0.0.1"), requests: []*proto.PlayRequest{start}}
+		err = interceptor.Stream()(nil, stream, info, receive(1))
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"1"}, stream.header.Get("retry-after"))
+		assert.Len(t, stream.requests, 1)
+	})
+
+	t.Run("taps share the bucket of unary Tap calls", func(t *testing.T) {
+		interceptor, _ := newTestRateLimitInterceptor(limits)
+		ctx := callerContext(1, "10.0.0.1")
+		for i := 0; i < 2; i++ {
+			_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: proto.GameService_Tap_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		}
+
+		stream := &fakeServerStream{ctx: ctx, requests: []*proto.PlayRequest{start, tap}}
+		err := interceptor.Stream()(nil, stream, info, receive(2))
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
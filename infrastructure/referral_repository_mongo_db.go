@@ -30,7 +30,7 @@ func NewMongoDbReferralRepository() *MongoDbReferralRepository {
 	}
 
 	// Send a ping to confirm a successful connection
-	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{"ping", 1}}).Err(); err != nil {
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
 		panic(err)
 	}
 	fmt.Println("Referral repository - Pinged your deployment. You successfully connected to MongoDB!")
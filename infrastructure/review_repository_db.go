@@ -0,0 +1,58 @@
+package infrastructure
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/bernardbaker/qiba.core/domain"
+)
+
+type InMemoryReviewRepository struct {
+	reviews map[string]domain.GameReview
+	mutex   sync.RWMutex
+}
+
+func NewInMemoryReviewRepository() *InMemoryReviewRepository {
+	return &InMemoryReviewRepository{
+		reviews: make(map[string]domain.GameReview),
+	}
+}
+
+// SaveReview stores a copy of review under its game ID
+func (repo *InMemoryReviewRepository) SaveReview(review *domain.GameReview) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	repo.reviews[review.GameID] = *review
+	return nil
+}
+
+// GetReview retrieves the review of a game
+func (repo *InMemoryReviewRepository) GetReview(gameID string) (*domain.GameReview, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	review, exists := repo.reviews[gameID]
+	if !exists {
+		return nil, domain.ErrReviewNotFound
+	}
+	return &review, nil
+}
+
+// ListReviews returns the oldest reviews with status
+func (repo *InMemoryReviewRepository) ListReviews(status domain.ReviewStatus, limit int) ([]*domain.GameReview, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	var reviews []*domain.GameReview
+	for _, review := range repo.reviews {
+		if review.Status == status {
+			review := review
+			reviews = append(reviews, &review)
+		}
+	}
+	slices.SortFunc(reviews, func(a, b *domain.GameReview) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	if limit > 0 && len(reviews) > limit {
+		reviews = reviews[:limit]
+	}
+	return reviews, nil
+}
@@ -0,0 +1,105 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/bernardbaker/qiba.core/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoDbReviewRepository struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+}
+
+func NewMongoDbReviewRepository() *MongoDbReviewRepository {
+	// Use the SetServerAPIOptions() method to set the version of the Stable API on the client
+	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
+	opts := options.Client().ApplyURI("mongodb+srv://" + os.Getenv("MONGO_DB_USER") + ":" + os.Getenv("MONGO_DB_PASSWORD") + "@" + os.Getenv("MONGO_DB_URL") + "/?retryWrites=true&w=majority&appName=qiba-game").SetServerAPIOptions(serverAPI)
+	// Create a new client and connect to the server
+	client, err := mongo.Connect(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Review repository - connection to MongoDB failed!")
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	// Send a ping to confirm a successful connection
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
+		panic(err)
+	}
+	fmt.Println("Review repository - Pinged your deployment. You successfully connected to MongoDB!")
+
+	collection := client.Database("qiba-game").Collection("game_reviews")
+	_, err = collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "GameID", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "Status", Value: 1}, {Key: "CreatedAt", Value: 1}},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &MongoDbReviewRepository{
+		client:     client,
+		collection: collection,
+	}
+}
+
+// SaveReview upserts the review of a game in MongoDB
+func (repo *MongoDbReviewRepository) SaveReview(review *domain.GameReview) error {
+	_, err := repo.collection.ReplaceOne(
+		context.Background(),
+		bson.M{"GameID": review.GameID},
+		review,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save review: %w", err)
+	}
+	return nil
+}
+
+// GetReview retrieves the review of a game from MongoDB
+func (repo *MongoDbReviewRepository) GetReview(gameID string) (*domain.GameReview, error) {
+	var review domain.GameReview
+	err := repo.collection.FindOne(context.Background(), bson.M{"GameID": gameID}).Decode(&review)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrReviewNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching review: %w", err)
+	}
+	return &review, nil
+}
+
+// ListReviews retrieves the oldest reviews with status from MongoDB
+func (repo *MongoDbReviewRepository) ListReviews(status domain.ReviewStatus, limit int) ([]*domain.GameReview, error) {
+	ctx := context.Background()
+	opts := options.Find().SetSort(bson.D{{Key: "CreatedAt", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := repo.collection.Find(ctx, bson.M{"Status": status}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching reviews: %w", err)
+	}
+
+	var reviews []*domain.GameReview
+	if err = cursor.All(ctx, &reviews); err != nil {
+		return nil, fmt.Errorf("error decoding reviews: %w", err)
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bernardbaker/qiba.core/domain"
+)
+
+// RulesFile is the JSON layout of a scoring rules file
+type RulesFile struct {
+	Rules []domain.Rules `json:"rules"`
+}
+
+// LoadRuleBook reads every rules version from the JSON file at path
+func LoadRuleBook(path string) (*domain.RuleBook, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+	var file RulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file: %w", err)
+	}
+	return domain.NewRuleBook(file.Rules)
+}
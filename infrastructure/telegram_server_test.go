@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/telegram"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitData(t *testing.T) {
+	const botToken = "123456789:test-bot-token"
+	sessions, err := auth.NewIssuer([]byte("0123456789abcdef0123456789abcdef"), auth.DefaultSessionTTL)
+	assert.NoError(t, err)
+	server := NewTelegramServer(telegram.NewValidator(botToken, telegram.DefaultMaxAge), sessions)
+	// fields are initData for user 42 issued at authDate
+	fields := func(authDate time.Time) url.Values {
+		return url.Values{
+			"user":        {`{"id":42,"first_name":"Ada","username":"ada"}`},
+			"start_param": {"bonus"},
+			"auth_date":   {strconv.FormatInt(authDate.Unix(), 10)},
+		}
+	}
+	// resign changes the signed initData with change and encodes it again
+	resign := func(initData string, change func(url.Values)) string {
+		values, err := url.ParseQuery(initData)
+		assert.NoError(t, err)
+		change(values)
+		return values.Encode()
+	}
+	call := func(initData string) *proto.InitDataResponse {
+		res, err := server.InitData(context.Background(), &proto.InitDataRequest{InitData: initData})
+		assert.NoError(t, err)
+		return res
+	}
+
+	t.Run("valid initData returns its user and a session for them", func(t *testing.T) {
+		res := call(telegram.Sign(botToken, fields(time.Now())))
+
+		assert.True(t, res.Success, res.ErrorMessage)
+		if assert.NotNil(t, res.User) {
+			assert.Equal(t, int64(42), res.User.UserId)
+			assert.Equal(t, "ada", res.User.Username)
+		}
+		assert.Equal(t, "bonus", res.Payload)
+		session, err := sessions.Verify(res.SessionToken)
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(42), session.UserID)
+		}
+	})
+
+	t.Run("an expired auth_date is refused", func(t *testing.T) {
+		res := call(telegram.Sign(botToken, fields(time.Now().Add(-telegram.DefaultMaxAge-time.Minute))))
+
+		assert.False(t, res.Success)
+		assert.Contains(t, res.ErrorMessage, telegram.ErrInitDataExpired.Error())
+		assert.Nil(t, res.User)
+		assert.Empty(t, res.SessionToken)
+	})
+
+	t.Run("a tampered hash is refused", func(t *testing.T) {
+		tampered := resign(telegram.Sign(botToken, fields(time.Now())), func(values url.Values) {
+			hash := []byte(values.Get("hash"))
+			hash[0] = map[bool]byte{true: 'b', false: 'a'}[hash[0] == 'a']
+			values.Set("hash", string(hash))
+		})
+
+		res := call(tampered)
+
+		assert.False(t, res.Success)
+		assert.Contains(t, res.ErrorMessage, "hash mismatch")
+		assert.Nil(t, res.User)
+		assert.Empty(t, res.SessionToken)
+	})
+
+	t.Run("a changed user fails the hash", func(t *testing.T) {
+		tampered := resign(telegram.Sign(botToken, fields(time.Now())), func(values url.Values) {
+			values.Set("user", `{"id":7,"first_name":"Ada","username":"ada"}`)
+		})
+
+		res := call(tampered)
+
+		assert.False(t, res.Success)
+		assert.Contains(t, res.ErrorMessage, "hash mismatch")
+	})
+
+	t.Run("initData without a hash is refused", func(t *testing.T) {
+		unsigned := resign(telegram.Sign(botToken, fields(time.Now())), func(values url.Values) {
+			values.Del("hash")
+		})
+
+		res := call(unsigned)
+
+		assert.False(t, res.Success)
+		assert.Contains(t, res.ErrorMessage, "missing hash")
+		assert.Nil(t, res.User)
+		assert.Empty(t, res.SessionToken)
+	})
+
+	t.Run("nothing is valid without a bot token", func(t *testing.T) {
+		res, err := NewTelegramServer(nil, sessions).InitData(context.Background(), &proto.InitDataRequest{InitData: telegram.Sign(botToken, fields(time.Now()))})
+
+		assert.NoError(t, err)
+		assert.False(t, res.Success)
+		assert.Empty(t, res.SessionToken)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
 )
@@ -49,10 +50,72 @@ func (repo *InMemoryUserRepository) Update(user *domain.User) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
 	userIdStr := strconv.FormatInt(user.UserId, 10)
-	_, exists := repo.users[userIdStr]
+	existing, exists := repo.users[userIdStr]
 	if !exists {
 		fmt.Println("User Repository Update user not found")
+	} else {
+		// Plays are only claimed through ClaimPlay and users are only
+		// moderated through SetModeration
+		user.LastPlayAt = existing.LastPlayAt
+		user.Moderation = existing.Moderation
 	}
 	repo.users[userIdStr] = user
 	return nil
 }
+
+// ClaimPlay records a free play at now unless one was claimed after since
+func (repo *InMemoryUserRepository) ClaimPlay(userID string, now, since time.Time) (bool, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	user, exists := repo.users[userID]
+	if !exists {
+		return false, errors.New("user not found")
+	}
+	if user.LastPlayAt.After(since) {
+		return false, nil
+	}
+	user.LastPlayAt = now
+	return true, nil
+}
+
+// AdjustBonusGames adds delta to the user's bonus games unless that would
+// leave fewer than none
+func (repo *InMemoryUserRepository) AdjustBonusGames(userID string, delta int64) (bool, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	user, exists := repo.users[userID]
+	if !exists {
+		return false, errors.New("user not found")
+	}
+	if user.BonusGames+delta < 0 {
+		return false, nil
+	}
+	user.BonusGames += delta
+	return true, nil
+}
+
+// SetModeration changes the user's moderation status
+func (repo *InMemoryUserRepository) SetModeration(userID string, status domain.ModerationStatus) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	user, exists := repo.users[userID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	user.Moderation = status
+	return nil
+}
+
+// ListModeratedUsers returns copies of the quarantined and banned users
+func (repo *InMemoryUserRepository) ListModeratedUsers() ([]*domain.User, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+	var users []*domain.User
+	for _, user := range repo.users {
+		if user.IsModerated() {
+			moderated := *user
+			users = append(users, &moderated)
+		}
+	}
+	return users, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/bernardbaker/qiba.core/domain"
 
@@ -36,7 +37,7 @@ func NewMongoDbUserRepository() *MongoDbUserRepository {
 	}
 
 	// Send a ping to confirm a successful connection
-	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{"ping", 1}}).Err(); err != nil {
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Err(); err != nil {
 		panic(err)
 	}
 	fmt.Println("User repository - Pinged your deployment. You successfully connected to MongoDB!")
@@ -114,3 +115,65 @@ func (r *MongoDbUserRepository) Update(user *domain.User) error {
 	)
 	return err
 }
+
+// ClaimPlay records a free play at now unless the user already claimed one
+// after since. The condition and the update are applied as one operation.
+func (r *MongoDbUserRepository) ClaimPlay(id string, now, since time.Time) (bool, error) {
+	userId, _ := strconv.ParseInt(id, 10, 64)
+	filter := bson.M{
+		"UserId": userId,
+		"$or": bson.A{
+			bson.M{"LastPlayAt": bson.M{"$exists": false}},
+			bson.M{"LastPlayAt": bson.M{"$lte": since}},
+		},
+	}
+	result, err := r.collection.UpdateOne(context.TODO(), filter, bson.M{"$set": bson.M{"LastPlayAt": now}})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim play: %w", err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// AdjustBonusGames adds delta to the user's bonus games unless that would
+// leave fewer than none. The condition and the update are applied as one
+// operation.
+func (r *MongoDbUserRepository) AdjustBonusGames(id string, delta int64) (bool, error) {
+	userId, _ := strconv.ParseInt(id, 10, 64)
+	filter := bson.M{"UserId": userId}
+	if delta < 0 {
+		filter["BonusGames"] = bson.M{"$gte": -delta}
+	}
+	result, err := r.collection.UpdateOne(context.TODO(), filter, bson.M{"$inc": bson.M{"BonusGames": delta}})
+	if err != nil {
+		return false, fmt.Errorf("failed to adjust bonus games: %w", err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// SetModeration changes the user's moderation status
+func (r *MongoDbUserRepository) SetModeration(id string, status domain.ModerationStatus) error {
+	userId, _ := strconv.ParseInt(id, 10, 64)
+	result, err := r.collection.UpdateOne(context.TODO(), bson.M{"UserId": userId}, bson.M{"$set": bson.M{"Moderation": status}})
+	if err != nil {
+		return fmt.Errorf("failed to moderate user: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+// ListModeratedUsers returns the quarantined and banned users
+func (r *MongoDbUserRepository) ListModeratedUsers() ([]*domain.User, error) {
+	ctx := context.TODO()
+	filter := bson.M{"Moderation": bson.M{"$in": bson.A{domain.ModerationQuarantined, domain.ModerationBanned}}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching moderated users: %w", err)
+	}
+	var users []*domain.User
+	if err = cursor.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("error decoding moderated users: %w", err)
+	}
+	return users, nil
+}
@@ -1,15 +1,31 @@
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"log"
+	"math"
 	"net"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/bernardbaker/qiba.core/app"
+	"github.com/bernardbaker/qiba.core/auth"
+	"github.com/bernardbaker/qiba.core/domain"
 	"github.com/bernardbaker/qiba.core/infrastructure"
 	"github.com/bernardbaker/qiba.core/ports"
 	"github.com/bernardbaker/qiba.core/proto"
+	"github.com/bernardbaker/qiba.core/ratelimit"
+	"github.com/bernardbaker/qiba.core/receipt"
+	"github.com/bernardbaker/qiba.core/telegram"
 
 	"google.golang.org/grpc"
 )
@@ -24,16 +40,20 @@ const (
 
 func getRepositories(repoType RepositoryType) (
 	gameRepo ports.GameRepository,
+	gameEventRepo ports.GameEventRepository,
 	userRepo ports.UserRepository,
 	leaderboardRepo ports.LeaderboardRepository,
 	referralRepo ports.ReferralRepository,
+	reviewRepo ports.ReviewRepository,
 ) {
 	switch repoType {
 	case InMemory:
 		return infrastructure.NewInMemoryGameRepository(),
+			infrastructure.NewInMemoryGameEventRepository(),
 			infrastructure.NewInMemoryUserRepository(),
 			infrastructure.NewInMemoryLeaderboardRepository(),
-			infrastructure.NewInMemoryReferralRepository()
+			infrastructure.NewInMemoryReferralRepository(),
+			infrastructure.NewInMemoryReviewRepository()
 	// case MongoDB:
 	// 	return infrastructure.NewInMemoryGameRepository(),
 	// 		infrastructure.NewInMemoryUserRepository(),
@@ -41,15 +61,19 @@ func getRepositories(repoType RepositoryType) (
 	// 		infrastructure.NewInMemoryReferralRepository()
 	case MongoDB:
 		return infrastructure.NewMongoDbGameRepository(),
+			infrastructure.NewMongoDbGameEventRepository(),
 			infrastructure.NewMongoDbUserRepository(),
 			infrastructure.NewMongoDbLeaderboardRepository(),
-			infrastructure.NewMongoDbReferralRepository()
+			infrastructure.NewMongoDbReferralRepository(),
+			infrastructure.NewMongoDbReviewRepository()
 	default:
 		log.Printf("Unknown repository type %s, falling back to in-memory", repoType)
 		return infrastructure.NewInMemoryGameRepository(),
+			infrastructure.NewInMemoryGameEventRepository(),
 			infrastructure.NewInMemoryUserRepository(),
 			infrastructure.NewInMemoryLeaderboardRepository(),
-			infrastructure.NewInMemoryReferralRepository()
+			infrastructure.NewInMemoryReferralRepository(),
+			infrastructure.NewInMemoryReviewRepository()
 	}
 }
 
@@ -75,6 +99,126 @@ func disableAllLogs() {
 	os.Stdout = null
 }
 
+// loadKeyring reads the encryption keys from ENCRYPTION_KEYS_FILE or
+// ENCRYPTION_KEYS. Development falls back to a fixed key.
+func loadKeyring() (*infrastructure.Keyring, error) {
+	if path := os.Getenv("ENCRYPTION_KEYS_FILE"); path != "" {
+		return infrastructure.LoadKeyringFile(path)
+	}
+	if os.Getenv("ENCRYPTION_KEYS") != "" {
+		return infrastructure.LoadKeyringEnv("ENCRYPTION_KEYS")
+	}
+	if os.Getenv("ENV") != "development" {
+		return nil, fmt.Errorf("%w: set ENCRYPTION_KEYS_FILE or ENCRYPTION_KEYS", infrastructure.ErrInvalidKeyring)
+	}
+	log.Printf("No encryption keys configured, using the development key")
+	key, err := infrastructure.NewKey("dev", []byte("mysecretencryptionkey1234567890a"))
+	if err != nil {
+		return nil, err
+	}
+	return infrastructure.NewKeyring(key.ID, key)
+}
+
+// loadReceiptSigner reads the base64 Ed25519 seed that signs score receipts
+// from RECEIPT_SIGNING_KEY and its ID from RECEIPT_KEY_ID. Development falls
+// back to a key generated at startup.
+func loadReceiptSigner() (*receipt.Signer, error) {
+	keyID := os.Getenv("RECEIPT_KEY_ID")
+	if keyID == "" {
+		keyID = "receipt-1"
+	}
+	if encoded := os.Getenv("RECEIPT_SIGNING_KEY"); encoded != "" {
+		seed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("RECEIPT_SIGNING_KEY must be a base64 %d byte Ed25519 seed", ed25519.SeedSize)
+		}
+		return receipt.NewSigner(keyID, ed25519.NewKeyFromSeed(seed)), nil
+	}
+	if os.Getenv("ENV") != "development" {
+		return nil, errors.New("set RECEIPT_SIGNING_KEY")
+	}
+	log.Printf("No receipt signing key configured, generating a development key")
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return receipt.NewSigner("dev", key), nil
+}
+
+// checkDailyChallengeSalt requires DAILY_CHALLENGE_SALT when a daily mode is
+// registered, as anyone can work out an unsalted day's seed and play it in
+// advance. Development only warns.
+func checkDailyChallengeSalt(modes *domain.ModeRegistry) error {
+	if os.Getenv("DAILY_CHALLENGE_SALT") != "" {
+		return nil
+	}
+	if !slices.ContainsFunc(modes.Modes(), func(mode *domain.GameMode) bool { return mode.Daily }) {
+		return nil
+	}
+	if os.Getenv("ENV") != "development" {
+		return errors.New("set DAILY_CHALLENGE_SALT")
+	}
+	log.Printf("No daily challenge salt configured, daily sequences can be predicted")
+	return nil
+}
+
+// loadReplayDelay reads how many minutes after a game the next free play is
+// available from REPLAY_GAME_DELAY_IN_MINUTES, domain.DefaultReplayDelay when
+// it is not set. Development falls back to no delay.
+func loadReplayDelay() (time.Duration, error) {
+	value := os.Getenv("REPLAY_GAME_DELAY_IN_MINUTES")
+	if value == "" {
+		if os.Getenv("ENV") != "development" {
+			return domain.DefaultReplayDelay, nil
+		}
+		log.Printf("No replay delay configured, free plays are always available")
+		return 0, nil
+	}
+	minutes, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(minutes) || math.IsInf(minutes, 0) || minutes < 0 {
+		return 0, fmt.Errorf("REPLAY_GAME_DELAY_IN_MINUTES must be a number of minutes, got %q", value)
+	}
+	return time.Duration(minutes * float64(time.Minute)), nil
+}
+
+// loadSessionIssuer signs sessions with SESSION_SECRET, at least 32 bytes,
+// lasting SESSION_TTL seconds. Development falls back to a secret generated
+// at startup.
+func loadSessionIssuer() (*auth.Issuer, error) {
+	ttl := auth.DefaultSessionTTL
+	if seconds, err := strconv.Atoi(os.Getenv("SESSION_TTL")); err == nil && seconds > 0 {
+		ttl = time.Duration(seconds) * time.Second
+	}
+	if secret := os.Getenv("SESSION_SECRET"); secret != "" {
+		return auth.NewIssuer([]byte(secret), ttl)
+	}
+	if os.Getenv("ENV") != "development" {
+		return nil, errors.New("set SESSION_SECRET")
+	}
+	log.Printf("No session secret configured, generating a development secret")
+	secret := make([]byte, auth.MinSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return auth.NewIssuer(secret, ttl)
+}
+
+// reloadKeyringOnHangup reloads the keyring file when the process gets
+// SIGHUP so keys can be rotated without a restart
+func reloadKeyringOnHangup(keys *infrastructure.Keyring) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		for range hangup {
+			if err := keys.Reload(); err != nil {
+				log.Printf("failed to reload encryption keys, keeping the current keys: %v", err)
+				continue
+			}
+			log.Printf("Reloaded encryption keys %v, current key %s", keys.IDs(), keys.Current().ID)
+		}
+	}()
+}
+
 func main() {
 	if os.Getenv("ENV") != "development" {
 		disableAllLogs()
@@ -100,15 +244,98 @@ func main() {
 	}
 
 	// Initialize repositories based on type
-	gameRepo, userRepo, leaderboardRepo, referralRepo := getRepositories(repoType)
+	gameRepo, gameEventRepo, userRepo, leaderboardRepo, referralRepo, reviewRepo := getRepositories(repoType)
 
 	// Initialize encrypter
-	encrypter := infrastructure.NewEncrypter([]byte("mysecretencryptionkey1234567890a"))
+	keys, err := loadKeyring()
+	if err != nil {
+		log.Fatalf("failed to load encryption keys: %v", err)
+	}
+	if os.Getenv("ENCRYPTION_KEYS_FILE") != "" {
+		reloadKeyringOnHangup(keys)
+	}
+	encrypter := infrastructure.NewEncrypter(keys)
+	// Initialize the score receipt signer
+	signer, err := loadReceiptSigner()
+	if err != nil {
+		log.Fatalf("failed to load receipt signing key: %v", err)
+	}
+	// Load the scoring rules
+	rules := domain.DefaultRuleBook()
+	if rulesFile := os.Getenv("RULES_FILE"); rulesFile != "" {
+		book, err := infrastructure.LoadRuleBook(rulesFile)
+		if err != nil {
+			log.Fatalf("failed to load rules: %v", err)
+		}
+		rules = book
+	}
+	// Register the game modes, timed games last GAME_DURATION seconds
+	gameDuration, err := strconv.Atoi(os.Getenv("GAME_DURATION"))
+	if err != nil || gameDuration <= 0 {
+		gameDuration = 60
+	}
+	modes, err := domain.NewModeRegistry(domain.DefaultGameModes(time.Duration(gameDuration)*time.Second), rules)
+	if err != nil {
+		log.Fatalf("failed to register game modes: %v", err)
+	}
+	if err := checkDailyChallengeSalt(modes); err != nil {
+		log.Fatalf("failed to configure the daily challenge: %v", err)
+	}
+	replayDelay, err := loadReplayDelay()
+	if err != nil {
+		log.Fatalf("failed to load replay delay: %v", err)
+	}
 	// Initialize game service
-	service := app.NewGameService(gameRepo, userRepo, leaderboardRepo, encrypter)
+	service := app.NewGameService(gameRepo, gameEventRepo, userRepo, leaderboardRepo, reviewRepo, encrypter, signer, rules, modes, replayDelay)
 	// Initialize referral service
 	referralService := app.NewReferralService(referralRepo)
 
+	// Sessions identify callers, REQUIRE_AUTH=false lets calls without one
+	// through while clients move over to sessions
+	sessions, err := loadSessionIssuer()
+	if err != nil {
+		log.Fatalf("failed to load session secret: %v", err)
+	}
+	requireAuth := os.Getenv("REQUIRE_AUTH") != "false"
+	if !requireAuth {
+		log.Printf("Sessions are not required, unauthenticated callers are trusted")
+	}
+
+	// Validate Telegram Mini App initData for the bot with BOT_TOKEN, accepting
+	// initData up to INIT_DATA_MAX_AGE seconds old. Sessions are only issued
+	// for valid initData, so they can't be required without a bot token.
+	var initData *telegram.Validator
+	if botToken := os.Getenv("BOT_TOKEN"); botToken != "" {
+		maxAge := telegram.DefaultMaxAge
+		if seconds, err := strconv.Atoi(os.Getenv("INIT_DATA_MAX_AGE")); err == nil && seconds > 0 {
+			maxAge = time.Duration(seconds) * time.Second
+		}
+		initData = telegram.NewValidator(botToken, maxAge)
+	} else if requireAuth {
+		log.Fatalf("failed to configure sessions: set BOT_TOKEN or REQUIRE_AUTH=false")
+	} else {
+		log.Printf("No BOT_TOKEN configured, init data will not be validated")
+	}
+	authInterceptor := infrastructure.NewAuthInterceptor(sessions, requireAuth, infrastructure.PublicMethods...)
+
+	// Rate limit the game and referral RPCs, RATE_LIMITS overrides the default
+	// limits with JSON
+	rateLimits := infrastructure.DefaultRateLimits()
+	if value := os.Getenv("RATE_LIMITS"); value != "" {
+		if rateLimits, err = infrastructure.ParseRateLimits([]byte(value)); err != nil {
+			log.Fatalf("failed to load rate limits: %v", err)
+		}
+	}
+	rateLimitInterceptor := infrastructure.NewRateLimitInterceptor(ratelimit.NewMemoryStore(), rateLimits, infrastructure.RateLimitedServices...)
+
+	// Finalize games that were never ended
+	reaperInterval, err := strconv.Atoi(os.Getenv("GAME_REAPER_INTERVAL"))
+	if err != nil || reaperInterval <= 0 {
+		reaperInterval = 30
+	}
+	reaper := app.NewGameReaper(service, time.Duration(reaperInterval)*time.Second)
+	go reaper.Run(context.Background())
+
 	// Prepopulate the leaderboard
 	// TODO: if the users score is not in the top 100 find it and display it.
 	prepopulate := false
@@ -116,7 +343,9 @@ func main() {
 		// Initialize the leader board
 		service.CreateLeaderboard("dev", prepopulate)
 	} else {
-		service.CreateLeaderboard("qiba", false)
+		for _, board := range modes.Leaderboards() {
+			service.CreateLeaderboard(board, false)
+		}
 	}
 
 	// Setting new Logger
@@ -129,11 +358,15 @@ func main() {
 	}
 	log.Printf("Server listening at %v", listener.Addr().String())
 
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary(), rateLimitInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream(), rateLimitInterceptor.Stream()),
+	)
 
 	// Register gRPC services
 	proto.RegisterGameServiceServer(server, infrastructure.NewGameServer(service))
 	proto.RegisterReferralServiceServer(server, infrastructure.NewReferralServer(referralService, service))
+	proto.RegisterTelegramMiniAppServer(server, infrastructure.NewTelegramServer(initData, sessions))
 
 	if err := server.Serve(listener); err != nil {
 		log.Fatalf("failed to serve: %v", err)
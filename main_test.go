@@ -7,6 +7,26 @@ import (
 	"time"
 )
 
+// Test servers need keys as they don't run in development
+func TestMain(m *testing.M) {
+	if os.Getenv("ENCRYPTION_KEYS_FILE") == "" && os.Getenv("ENCRYPTION_KEYS") == "" {
+		os.Setenv("ENCRYPTION_KEYS", `{"current":"test","keys":[{"id":"test","secret":"dGVzdC1lbmNyeXB0aW9uLWtleS0xMjM0NTY3ODkwYWJjZGVm"}]}`)
+	}
+	if os.Getenv("RECEIPT_SIGNING_KEY") == "" {
+		os.Setenv("RECEIPT_SIGNING_KEY", "dGVzdC1yZWNlaXB0LXNpZ25pbmcta2V5LTEyMzQ1Njc=")
+	}
+	if os.Getenv("SESSION_SECRET") == "" {
+		os.Setenv("SESSION_SECRET", "test-session-secret-1234567890abcdef")
+	}
+	if os.Getenv("BOT_TOKEN") == "" {
+		os.Setenv("BOT_TOKEN", "123456789:test-bot-token")
+	}
+	if os.Getenv("DAILY_CHALLENGE_SALT") == "" {
+		os.Setenv("DAILY_CHALLENGE_SALT", "test-daily-challenge-salt")
+	}
+	os.Exit(m.Run())
+}
+
 // For testing multiple instances
 func StartServer() error {
 	port := os.Getenv("PORT")
@@ -88,3 +108,42 @@ func TestMainWithDelay(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadReplayDelay(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   string
+		value string
+		delay time.Duration
+		fails bool
+	}{
+		{"Unset in production", "production", "", time.Hour, false},
+		{"Unset in development", "development", "", 0, false},
+		{"Fractional minutes", "production", "0.5", 30 * time.Second, false},
+		{"Explicit zero", "production", "0", 0, false},
+		{"Not a number", "production", "soon", 0, true},
+		{"Negative", "production", "-5", 0, true},
+		{"Infinite", "production", "Inf", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV", tt.env)
+			t.Setenv("REPLAY_GAME_DELAY_IN_MINUTES", tt.value)
+
+			delay, err := loadReplayDelay()
+			if tt.fails {
+				if err == nil {
+					t.Fatalf("%q should be refused, got %v", tt.value, delay)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%q should load: %v", tt.value, err)
+			}
+			if delay != tt.delay {
+				t.Fatalf("%q loaded as %v, want %v", tt.value, delay, tt.delay)
+			}
+		})
+	}
+}
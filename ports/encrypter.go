@@ -1,5 +1,16 @@
 package ports
 
+import "errors"
+
+// ErrGameDataTampered is returned for game data that was not produced by the
+// Encrypter, or was changed after it was
+var ErrGameDataTampered = errors.New("game data has been tampered with")
+
 type Encrypter interface {
+	// EncryptGameData returns data encrypted and an HMAC of the result
 	EncryptGameData(data interface{}) (string, string, error)
+	// VerifyGameData checks the HMAC of encrypted data
+	VerifyGameData(encryptedData, hmac string) error
+	// DecryptGameData verifies encrypted data and decodes it into v
+	DecryptGameData(encryptedData, hmac string, v interface{}) error
 }
@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/bernardbaker/qiba.core/domain"
+
+// GameEventRepository defines the append-only store for game event logs
+type GameEventRepository interface {
+	// AppendEvent adds event to the end of its game's log and sets its sequence
+	AppendEvent(event *domain.GameEvent) error
+	// GetEvents returns a game's events in the order they were appended
+	GetEvents(gameID string) ([]*domain.GameEvent, error)
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"time"
+
 	"github.com/bernardbaker/qiba.core/domain"
 )
 
@@ -10,4 +12,10 @@ type GameRepository interface {
 	GetGame(gameID string) (*domain.Game, error)
 	UpdateGame(game *domain.Game) error
 	GetGamesByUser(userID string) ([]*domain.Game, error)
+	// GetExpiredGames returns the open or expired games whose duration ran out
+	// before now and that were never finalized
+	GetExpiredGames(now time.Time) ([]*domain.Game, error)
+	// ListGames returns one page of the games matching query. Games in a page
+	// are loaded without their object sequence.
+	ListGames(query domain.GameQuery) (*domain.GamePage, error)
 }
@@ -0,0 +1,8 @@
+package ports
+
+import "github.com/bernardbaker/qiba.core/receipt"
+
+type ReceiptSigner interface {
+	Sign(r receipt.Receipt) (string, error)
+	PublicKeys() []receipt.PublicKey
+}
@@ -0,0 +1,13 @@
+package ports
+
+import "github.com/bernardbaker/qiba.core/domain"
+
+// ReviewRepository stores the moderation review queue
+type ReviewRepository interface {
+	// SaveReview stores review, replacing an earlier review of the same game
+	SaveReview(review *domain.GameReview) error
+	// GetReview returns the review of a game or domain.ErrReviewNotFound
+	GetReview(gameID string) (*domain.GameReview, error)
+	// ListReviews returns up to limit reviews with status, oldest first
+	ListReviews(status domain.ReviewStatus, limit int) ([]*domain.GameReview, error)
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"time"
+
 	"github.com/bernardbaker/qiba.core/domain"
 )
 
@@ -9,4 +11,15 @@ type UserRepository interface {
 	Save(obj *domain.User) error
 	Get(objID string) (*domain.User, error)
 	Update(obj *domain.User) error
+	// ClaimPlay records a free play at now unless the user already claimed
+	// one after since, and reports whether the play was claimed
+	ClaimPlay(objID string, now, since time.Time) (bool, error)
+	// AdjustBonusGames adds delta to the user's bonus games unless that would
+	// leave fewer than none, and reports whether the change was made
+	AdjustBonusGames(objID string, delta int64) (bool, error)
+	// SetModeration changes the user's moderation status, it returns
+	// domain.ErrUserNotFound for unknown users
+	SetModeration(objID string, status domain.ModerationStatus) error
+	// ListModeratedUsers returns the users that are quarantined or banned
+	ListModeratedUsers() ([]*domain.User, error)
 }
@@ -647,16 +647,25 @@ var TelegramMiniApp_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	GameService_StartGame_FullMethodName   = "/qiba.GameService/StartGame"
-	GameService_Spawn_FullMethodName       = "/qiba.GameService/Spawn"
-	GameService_Tap_FullMethodName         = "/qiba.GameService/Tap"
-	GameService_EndGame_FullMethodName     = "/qiba.GameService/EndGame"
-	GameService_CanPlay_FullMethodName     = "/qiba.GameService/CanPlay"
-	GameService_Leaderboard_FullMethodName = "/qiba.GameService/Leaderboard"
-	GameService_GameTime_FullMethodName    = "/qiba.GameService/GameTime"
-	GameService_MaxPlays_FullMethodName    = "/qiba.GameService/MaxPlays"
-	GameService_PlayCount_FullMethodName   = "/qiba.GameService/PlayCount"
-	GameService_PlaysLeft_FullMethodName   = "/qiba.GameService/PlaysLeft"
+	GameService_StartGame_FullMethodName     = "/qiba.GameService/StartGame"
+	GameService_Spawn_FullMethodName         = "/qiba.GameService/Spawn"
+	GameService_SpawnStream_FullMethodName   = "/qiba.GameService/SpawnStream"
+	GameService_Tap_FullMethodName           = "/qiba.GameService/Tap"
+	GameService_PlaySession_FullMethodName   = "/qiba.GameService/PlaySession"
+	GameService_EndGame_FullMethodName       = "/qiba.GameService/EndGame"
+	GameService_GetGameReplay_FullMethodName = "/qiba.GameService/GetGameReplay"
+	GameService_ListGames_FullMethodName     = "/qiba.GameService/ListGames"
+	GameService_ReceiptKeys_FullMethodName   = "/qiba.GameService/ReceiptKeys"
+	GameService_CanPlay_FullMethodName       = "/qiba.GameService/CanPlay"
+	GameService_Leaderboard_FullMethodName   = "/qiba.GameService/Leaderboard"
+	GameService_GameTime_FullMethodName      = "/qiba.GameService/GameTime"
+	GameService_MaxPlays_FullMethodName      = "/qiba.GameService/MaxPlays"
+	GameService_PlayCount_FullMethodName     = "/qiba.GameService/PlayCount"
+	GameService_PlaysLeft_FullMethodName     = "/qiba.GameService/PlaysLeft"
+	GameService_ModerateUser_FullMethodName  = "/qiba.GameService/ModerateUser"
+	GameService_ListReviews_FullMethodName   = "/qiba.GameService/ListReviews"
+	GameService_ApproveReview_FullMethodName = "/qiba.GameService/ApproveReview"
+	GameService_RejectReview_FullMethodName  = "/qiba.GameService/RejectReview"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -665,14 +674,23 @@ const (
 type GameServiceClient interface {
 	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error)
 	Spawn(ctx context.Context, in *SpawnRequest, opts ...grpc.CallOption) (*SpawnResponse, error)
+	SpawnStream(ctx context.Context, in *SpawnStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpawnEvent], error)
 	Tap(ctx context.Context, in *TapRequest, opts ...grpc.CallOption) (*TapResponse, error)
+	PlaySession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlayRequest, PlayEvent], error)
 	EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*EndGameResponse, error)
+	GetGameReplay(ctx context.Context, in *GameReplayRequest, opts ...grpc.CallOption) (*GameReplayResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	ReceiptKeys(ctx context.Context, in *ReceiptKeysRequest, opts ...grpc.CallOption) (*ReceiptKeysResponse, error)
 	CanPlay(ctx context.Context, in *CanPlayGameRequest, opts ...grpc.CallOption) (*CanPlayGameResponse, error)
 	Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
 	GameTime(ctx context.Context, in *GameTimeRequest, opts ...grpc.CallOption) (*GameTimeResponse, error)
 	MaxPlays(ctx context.Context, in *MaxPlaysRequest, opts ...grpc.CallOption) (*MaxPlaysResponse, error)
 	PlayCount(ctx context.Context, in *PlayCountRequest, opts ...grpc.CallOption) (*PlayCountResponse, error)
 	PlaysLeft(ctx context.Context, in *PlaysLeftRequest, opts ...grpc.CallOption) (*PlaysLeftResponse, error)
+	ModerateUser(ctx context.Context, in *ModerateUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	ApproveReview(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*ReviewDecisionResponse, error)
+	RejectReview(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*ReviewDecisionResponse, error)
 }
 
 type gameServiceClient struct {
@@ -703,6 +721,25 @@ func (c *gameServiceClient) Spawn(ctx context.Context, in *SpawnRequest, opts ..
 	return out, nil
 }
 
+func (c *gameServiceClient) SpawnStream(ctx context.Context, in *SpawnStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpawnEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[0], GameService_SpawnStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SpawnStreamRequest, SpawnEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_SpawnStreamClient = grpc.ServerStreamingClient[SpawnEvent]
+
 func (c *gameServiceClient) Tap(ctx context.Context, in *TapRequest, opts ...grpc.CallOption) (*TapResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TapResponse)
@@ -713,6 +750,19 @@ func (c *gameServiceClient) Tap(ctx context.Context, in *TapRequest, opts ...grp
 	return out, nil
 }
 
+func (c *gameServiceClient) PlaySession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlayRequest, PlayEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[1], GameService_PlaySession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PlayRequest, PlayEvent]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_PlaySessionClient = grpc.BidiStreamingClient[PlayRequest, PlayEvent]
+
 func (c *gameServiceClient) EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*EndGameResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EndGameResponse)
@@ -723,6 +773,36 @@ func (c *gameServiceClient) EndGame(ctx context.Context, in *EndGameRequest, opt
 	return out, nil
 }
 
+func (c *gameServiceClient) GetGameReplay(ctx context.Context, in *GameReplayRequest, opts ...grpc.CallOption) (*GameReplayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameReplayResponse)
+	err := c.cc.Invoke(ctx, GameService_GetGameReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, GameService_ListGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ReceiptKeys(ctx context.Context, in *ReceiptKeysRequest, opts ...grpc.CallOption) (*ReceiptKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiptKeysResponse)
+	err := c.cc.Invoke(ctx, GameService_ReceiptKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameServiceClient) CanPlay(ctx context.Context, in *CanPlayGameRequest, opts ...grpc.CallOption) (*CanPlayGameResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CanPlayGameResponse)
@@ -783,20 +863,69 @@ func (c *gameServiceClient) PlaysLeft(ctx context.Context, in *PlaysLeftRequest,
 	return out, nil
 }
 
+func (c *gameServiceClient) ModerateUser(ctx context.Context, in *ModerateUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateUserResponse)
+	err := c.cc.Invoke(ctx, GameService_ModerateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, GameService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ApproveReview(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*ReviewDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewDecisionResponse)
+	err := c.cc.Invoke(ctx, GameService_ApproveReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RejectReview(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*ReviewDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewDecisionResponse)
+	err := c.cc.Invoke(ctx, GameService_RejectReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
 type GameServiceServer interface {
 	StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error)
 	Spawn(context.Context, *SpawnRequest) (*SpawnResponse, error)
+	SpawnStream(*SpawnStreamRequest, grpc.ServerStreamingServer[SpawnEvent]) error
 	Tap(context.Context, *TapRequest) (*TapResponse, error)
+	PlaySession(grpc.BidiStreamingServer[PlayRequest, PlayEvent]) error
 	EndGame(context.Context, *EndGameRequest) (*EndGameResponse, error)
+	GetGameReplay(context.Context, *GameReplayRequest) (*GameReplayResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	ReceiptKeys(context.Context, *ReceiptKeysRequest) (*ReceiptKeysResponse, error)
 	CanPlay(context.Context, *CanPlayGameRequest) (*CanPlayGameResponse, error)
 	Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
 	GameTime(context.Context, *GameTimeRequest) (*GameTimeResponse, error)
 	MaxPlays(context.Context, *MaxPlaysRequest) (*MaxPlaysResponse, error)
 	PlayCount(context.Context, *PlayCountRequest) (*PlayCountResponse, error)
 	PlaysLeft(context.Context, *PlaysLeftRequest) (*PlaysLeftResponse, error)
+	ModerateUser(context.Context, *ModerateUserRequest) (*ModerateUserResponse, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	ApproveReview(context.Context, *ReviewDecisionRequest) (*ReviewDecisionResponse, error)
+	RejectReview(context.Context, *ReviewDecisionRequest) (*ReviewDecisionResponse, error)
 	mustEmbedUnimplementedGameServiceServer()
 }
 
@@ -813,12 +942,27 @@ func (UnimplementedGameServiceServer) StartGame(context.Context, *StartGameReque
 func (UnimplementedGameServiceServer) Spawn(context.Context, *SpawnRequest) (*SpawnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Spawn not implemented")
 }
+func (UnimplementedGameServiceServer) SpawnStream(*SpawnStreamRequest, grpc.ServerStreamingServer[SpawnEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SpawnStream not implemented")
+}
 func (UnimplementedGameServiceServer) Tap(context.Context, *TapRequest) (*TapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Tap not implemented")
 }
+func (UnimplementedGameServiceServer) PlaySession(grpc.BidiStreamingServer[PlayRequest, PlayEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method PlaySession not implemented")
+}
 func (UnimplementedGameServiceServer) EndGame(context.Context, *EndGameRequest) (*EndGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
 }
+func (UnimplementedGameServiceServer) GetGameReplay(context.Context, *GameReplayRequest) (*GameReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameReplay not implemented")
+}
+func (UnimplementedGameServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedGameServiceServer) ReceiptKeys(context.Context, *ReceiptKeysRequest) (*ReceiptKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptKeys not implemented")
+}
 func (UnimplementedGameServiceServer) CanPlay(context.Context, *CanPlayGameRequest) (*CanPlayGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CanPlay not implemented")
 }
@@ -837,6 +981,18 @@ func (UnimplementedGameServiceServer) PlayCount(context.Context, *PlayCountReque
 func (UnimplementedGameServiceServer) PlaysLeft(context.Context, *PlaysLeftRequest) (*PlaysLeftResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaysLeft not implemented")
 }
+func (UnimplementedGameServiceServer) ModerateUser(context.Context, *ModerateUserRequest) (*ModerateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateUser not implemented")
+}
+func (UnimplementedGameServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedGameServiceServer) ApproveReview(context.Context, *ReviewDecisionRequest) (*ReviewDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReview not implemented")
+}
+func (UnimplementedGameServiceServer) RejectReview(context.Context, *ReviewDecisionRequest) (*ReviewDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReview not implemented")
+}
 func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
 func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
@@ -894,6 +1050,17 @@ func _GameService_Spawn_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_SpawnStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpawnStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).SpawnStream(m, &grpc.GenericServerStream[SpawnStreamRequest, SpawnEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_SpawnStreamServer = grpc.ServerStreamingServer[SpawnEvent]
+
 func _GameService_Tap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TapRequest)
 	if err := dec(in); err != nil {
@@ -912,6 +1079,13 @@ func _GameService_Tap_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_PlaySession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GameServiceServer).PlaySession(&grpc.GenericServerStream[PlayRequest, PlayEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_PlaySessionServer = grpc.BidiStreamingServer[PlayRequest, PlayEvent]
+
 func _GameService_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EndGameRequest)
 	if err := dec(in); err != nil {
@@ -930,6 +1104,60 @@ func _GameService_EndGame_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetGameReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGameReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGameReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGameReplay(ctx, req.(*GameReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ReceiptKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ReceiptKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ReceiptKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ReceiptKeys(ctx, req.(*ReceiptKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GameService_CanPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CanPlayGameRequest)
 	if err := dec(in); err != nil {
@@ -1038,6 +1266,78 @@ func _GameService_PlaysLeft_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_ModerateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ModerateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ModerateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ModerateUser(ctx, req.(*ModerateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ApproveReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ApproveReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ApproveReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ApproveReview(ctx, req.(*ReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RejectReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RejectReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RejectReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RejectReview(ctx, req.(*ReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1061,6 +1361,18 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EndGame",
 			Handler:    _GameService_EndGame_Handler,
 		},
+		{
+			MethodName: "GetGameReplay",
+			Handler:    _GameService_GetGameReplay_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _GameService_ListGames_Handler,
+		},
+		{
+			MethodName: "ReceiptKeys",
+			Handler:    _GameService_ReceiptKeys_Handler,
+		},
 		{
 			MethodName: "CanPlay",
 			Handler:    _GameService_CanPlay_Handler,
@@ -1085,8 +1397,36 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlaysLeft",
 			Handler:    _GameService_PlaysLeft_Handler,
 		},
+		{
+			MethodName: "ModerateUser",
+			Handler:    _GameService_ModerateUser_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _GameService_ListReviews_Handler,
+		},
+		{
+			MethodName: "ApproveReview",
+			Handler:    _GameService_ApproveReview_Handler,
+		},
+		{
+			MethodName: "RejectReview",
+			Handler:    _GameService_RejectReview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SpawnStream",
+			Handler:       _GameService_SpawnStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlaySession",
+			Handler:       _GameService_PlaySession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
 
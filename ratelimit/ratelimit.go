@@ -0,0 +1,118 @@
+// Package ratelimit limits how often a key, e.g. a user or a peer address,
+// may do something with token buckets. A bucket holds up to Burst tokens and
+// refills at Rate tokens a second; every call takes one token and is refused
+// while the bucket is empty.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is the size and refill rate of a token bucket
+type Limit struct {
+	// Tokens added per second
+	Rate float64 `json:"rate"`
+	// Most tokens the bucket holds, the calls allowed in a burst
+	Burst int `json:"burst"`
+}
+
+// Unlimited reports whether the limit lets every call through
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// Bucket names a token bucket and the limit it refills at
+type Bucket struct {
+	Key   string
+	Limit Limit
+}
+
+// Store keeps the token buckets. Take must be safe for concurrent use; a
+// store shared by several servers applies the same limit to all of them.
+type Store interface {
+	// Take takes a token from each of the buckets when all of them have one,
+	// and reports whether they did. Otherwise it takes none and returns how
+	// long until they all will.
+	Take(buckets []Bucket, now time.Time) (bool, time.Duration, error)
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens earned since the bucket was last used
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.last = now
+	}
+}
+
+// MemoryStore keeps the buckets of one server in memory. Buckets that have
+// refilled completely are dropped, they are the same as new ones.
+type MemoryStore struct {
+	buckets   map[string]*bucket
+	mutex     sync.Mutex
+	lastSweep time.Time
+}
+
+// How often MemoryStore drops refilled buckets
+const sweepInterval = time.Minute
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket)}
+}
+
+// Take takes a token from each of the buckets, creating full buckets for new
+// keys
+func (s *MemoryStore) Take(buckets []Bucket, now time.Time) (bool, time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.sweep(now)
+
+	var wait time.Duration
+	limited := make([]*bucket, 0, len(buckets))
+	for _, requested := range buckets {
+		if requested.Limit.Unlimited() {
+			continue
+		}
+		b, exists := s.buckets[requested.Key]
+		if !exists {
+			b = &bucket{tokens: float64(requested.Limit.Burst), last: now}
+			s.buckets[requested.Key] = b
+		}
+		// A changed limit applies from now on
+		b.limit = requested.Limit
+		b.refill(now)
+		if b.tokens < 1 {
+			seconds := (1 - b.tokens) / b.limit.Rate
+			wait = max(wait, time.Duration(math.Ceil(seconds*float64(time.Second))))
+		}
+		limited = append(limited, b)
+	}
+	if wait > 0 {
+		return false, wait, nil
+	}
+	for _, b := range limited {
+		b.tokens--
+	}
+	return true, 0, nil
+}
+
+// sweep drops the buckets that are full again, at most once a sweepInterval
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	limit := Limit{Rate: 2, Burst: 3}
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	take := func(store *MemoryStore, now time.Time, keys ...string) (bool, time.Duration) {
+		buckets := make([]Bucket, 0, len(keys))
+		for _, key := range keys {
+			buckets = append(buckets, Bucket{Key: key, Limit: limit})
+		}
+		ok, wait, err := store.Take(buckets, now)
+		assert.NoError(t, err)
+		return ok, wait
+	}
+
+	t.Run("a new bucket allows a burst", func(t *testing.T) {
+		store := NewMemoryStore()
+		for i := 0; i < limit.Burst; i++ {
+			ok, _ := take(store, start, "a")
+			assert.True(t, ok, "call %d", i)
+		}
+
+		ok, wait := take(store, start, "a")
+
+		assert.False(t, ok)
+		assert.Equal(t, 500*time.Millisecond, wait)
+	})
+
+	t.Run("an empty bucket refills at the rate", func(t *testing.T) {
+		store := NewMemoryStore()
+		for i := 0; i < limit.Burst; i++ {
+			take(store, start, "a")
+		}
+
+		ok, wait := take(store, start.Add(250*time.Millisecond), "a")
+		assert.False(t, ok)
+		assert.Equal(t, 250*time.Millisecond, wait)
+
+		ok, _ = take(store, start.Add(500*time.Millisecond), "a")
+		assert.True(t, ok)
+		ok, _ = take(store, start.Add(500*time.Millisecond), "a")
+		assert.False(t, ok)
+
+		// Never more than the burst, however long the bucket was left
+		for i := 0; i < limit.Burst; i++ {
+			ok, _ := take(store, start.Add(time.Hour), "a")
+			assert.True(t, ok, "call %d", i)
+		}
+		ok, _ = take(store, start.Add(time.Hour), "a")
+		assert.False(t, ok)
+	})
+
+	t.Run("keys have their own buckets", func(t *testing.T) {
+		store := NewMemoryStore()
+		for i := 0; i < limit.Burst; i++ {
+			take(store, start, "a")
+		}
+
+		ok, _ := take(store, start, "a")
+		assert.False(t, ok)
+		ok, _ = take(store, start, "b")
+		assert.True(t, ok)
+	})
+
+	t.Run("no token is taken unless every bucket has one", func(t *testing.T) {
+		store := NewMemoryStore()
+		for i := 0; i < limit.Burst; i++ {
+			take(store, start, "empty")
+		}
+
+		ok, wait := take(store, start, "full", "empty")
+		assert.False(t, ok)
+		assert.Equal(t, 500*time.Millisecond, wait)
+
+		// The refused call left the full bucket full
+		for i := 0; i < limit.Burst; i++ {
+			ok, _ := take(store, start, "full")
+			assert.True(t, ok, "call %d", i)
+		}
+	})
+
+	t.Run("an unlimited bucket is not counted", func(t *testing.T) {
+		store := NewMemoryStore()
+		buckets := []Bucket{{Key: "a", Limit: Limit{}}}
+		for i := 0; i < 100; i++ {
+			ok, _, err := store.Take(buckets, start)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		}
+		assert.Empty(t, store.buckets)
+	})
+
+	t.Run("refilled buckets are swept", func(t *testing.T) {
+		store := NewMemoryStore()
+		take(store, start, "a")
+		take(store, start, "b")
+		assert.Len(t, store.buckets, 2)
+
+		take(store, start.Add(sweepInterval), "c")
+
+		assert.Len(t, store.buckets, 1)
+		assert.Contains(t, store.buckets, "c")
+	})
+}
@@ -0,0 +1,131 @@
+// Package receipt issues and verifies signed score receipts. A receipt
+// proves qiba-core recorded a score; anyone holding the public keys from the
+// ReceiptKeys RPC can check one without calling back into qiba-core.
+//
+// A receipt token is two base64url (unpadded) parts joined by a dot: the JSON
+// encoded Receipt and the Ed25519 signature of the first part as it appears in
+// the token.
+package receipt
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// Version of the receipt layout
+	Version = 1
+	// Algorithm receipts are signed with
+	Algorithm = "Ed25519"
+)
+
+var (
+	ErrInvalidReceipt = errors.New("invalid receipt")
+	ErrUnknownKey     = errors.New("unknown receipt key")
+)
+
+// Receipt is the signed record of a finished game's score
+type Receipt struct {
+	Version   int       `json:"v"`
+	KeyID     string    `json:"kid"`
+	UserID    string    `json:"uid"`
+	GameID    string    `json:"gid"`
+	Score     int32     `json:"score"`
+	Mode      string    `json:"mode"`
+	Practice  bool      `json:"practice,omitempty"`
+	StartTime time.Time `json:"start"`
+	EndTime   time.Time `json:"end"`
+	IssuedAt  time.Time `json:"iat"`
+}
+
+// PublicKey is a key receipts can be verified with
+type PublicKey struct {
+	ID  string
+	Key ed25519.PublicKey
+}
+
+// ParsePublicKey builds a PublicKey from its ID and base64 encoded key, as
+// returned by the ReceiptKeys RPC
+func ParsePublicKey(id string, encoded string) (PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return PublicKey{}, fmt.Errorf("%w: public key %s is not a base64 Ed25519 key", ErrInvalidReceipt, id)
+	}
+	return PublicKey{ID: id, Key: ed25519.PublicKey(key)}, nil
+}
+
+// Encoded is the key as the ReceiptKeys RPC returns it
+func (k PublicKey) Encoded() string {
+	return base64.StdEncoding.EncodeToString(k.Key)
+}
+
+// Signer signs receipts with one private key. Public keys of earlier signing
+// keys can be kept so receipts they signed are still published as valid.
+type Signer struct {
+	keyID    string
+	key      ed25519.PrivateKey
+	previous []PublicKey
+}
+
+func NewSigner(keyID string, key ed25519.PrivateKey, previous ...PublicKey) *Signer {
+	return &Signer{keyID: keyID, key: key, previous: previous}
+}
+
+// Sign returns the receipt token of r, signed with the signer's key
+func (s *Signer) Sign(r Receipt) (string, error) {
+	r.Version = Version
+	r.KeyID = s.keyID
+	payload, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(s.key, []byte(encoded))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// PublicKeys lists the signer's public key followed by the previous keys
+func (s *Signer) PublicKeys() []PublicKey {
+	current := PublicKey{ID: s.keyID, Key: s.key.Public().(ed25519.PublicKey)}
+	return append([]PublicKey{current}, s.previous...)
+}
+
+// Verify checks token was signed by the key of keys it names and returns the
+// receipt it carries
+func Verify(token string, keys ...PublicKey) (*Receipt, error) {
+	encoded, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidReceipt)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", ErrInvalidReceipt)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidReceipt)
+	}
+	var r Receipt
+	if err := json.Unmarshal(payload, &r); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReceipt, err)
+	}
+	if r.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidReceipt, r.Version)
+	}
+
+	for _, key := range keys {
+		if key.ID != r.KeyID {
+			continue
+		}
+		if len(key.Key) != ed25519.PublicKeySize || !ed25519.Verify(key.Key, []byte(encoded), signature) {
+			return nil, fmt.Errorf("%w: bad signature", ErrInvalidReceipt)
+		}
+		return &r, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownKey, r.KeyID)
+}
@@ -0,0 +1,112 @@
+package receipt
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestSigner signs with a key derived from seed
+func newTestSigner(keyID string, seed byte, previous ...PublicKey) *Signer {
+	return NewSigner(keyID, ed25519.NewKeyFromSeed([]byte(strings.Repeat(string(seed), ed25519.SeedSize))), previous...)
+}
+
+func TestVerify(t *testing.T) {
+	end := time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC)
+	issued := Receipt{UserID: "42", GameID: "game1", Score: 12, Mode: "timed", StartTime: end.Add(-time.Minute), EndTime: end, IssuedAt: end}
+	signer := newTestSigner("k1", 'a')
+
+	t.Run("a signed receipt verifies", func(t *testing.T) {
+		token, err := signer.Sign(issued)
+		assert.NoError(t, err)
+
+		r, err := Verify(token, signer.PublicKeys()...)
+
+		assert.NoError(t, err)
+		expected := issued
+		expected.Version = Version
+		expected.KeyID = "k1"
+		assert.Equal(t, &expected, r)
+	})
+
+	t.Run("a changed receipt fails the signature", func(t *testing.T) {
+		token, err := signer.Sign(issued)
+		assert.NoError(t, err)
+		encoded, signature, _ := strings.Cut(token, ".")
+		payload, err := base64.RawURLEncoding.DecodeString(encoded)
+		assert.NoError(t, err)
+		forged := strings.Replace(string(payload), `"score":12`, `"score":99`, 1)
+		assert.NotEqual(t, string(payload), forged)
+
+		_, err = Verify(base64.RawURLEncoding.EncodeToString([]byte(forged))+"."+signature, signer.PublicKeys()...)
+
+		assert.ErrorIs(t, err, ErrInvalidReceipt)
+		assert.ErrorContains(t, err, "bad signature")
+	})
+
+	t.Run("a key with the same ID but another secret is refused", func(t *testing.T) {
+		token, err := newTestSigner("k1", 'b').Sign(issued)
+		assert.NoError(t, err)
+
+		_, err = Verify(token, signer.PublicKeys()...)
+
+		assert.ErrorIs(t, err, ErrInvalidReceipt)
+	})
+
+	t.Run("an unknown key is refused", func(t *testing.T) {
+		token, err := signer.Sign(issued)
+		assert.NoError(t, err)
+
+		_, err = Verify(token, newTestSigner("other", 'b').PublicKeys()...)
+
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("receipts signed by a previous key still verify", func(t *testing.T) {
+		token, err := signer.Sign(issued)
+		assert.NoError(t, err)
+		rotated := newTestSigner("k2", 'b', signer.PublicKeys()...)
+
+		r, err := Verify(token, rotated.PublicKeys()...)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "k1", r.KeyID)
+	})
+
+	t.Run("an unsupported version is refused", func(t *testing.T) {
+		payload, err := json.Marshal(Receipt{Version: Version + 1, KeyID: "k1"})
+		assert.NoError(t, err)
+		encoded := base64.RawURLEncoding.EncodeToString(payload)
+		signature := ed25519.Sign(signer.key, []byte(encoded))
+
+		_, err = Verify(encoded+"."+base64.RawURLEncoding.EncodeToString(signature), signer.PublicKeys()...)
+
+		assert.ErrorIs(t, err, ErrInvalidReceipt)
+		assert.ErrorContains(t, err, "unsupported version")
+	})
+
+	t.Run("a malformed token is refused", func(t *testing.T) {
+		for _, token := range []string{"", "no-dot", "!.!", "e30.!"} {
+			_, err := Verify(token, signer.PublicKeys()...)
+			assert.ErrorIs(t, err, ErrInvalidReceipt, token)
+		}
+	})
+}
+
+func TestParsePublicKey(t *testing.T) {
+	key := newTestSigner("k1", 'a').PublicKeys()[0]
+
+	parsed, err := ParsePublicKey("k1", key.Encoded())
+	assert.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	for _, encoded := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		_, err := ParsePublicKey("k1", encoded)
+		assert.ErrorIs(t, err, ErrInvalidReceipt, encoded)
+	}
+}
@@ -0,0 +1,186 @@
+// Package telegram validates the initData a Telegram Mini App is launched
+// with. Telegram signs initData with a key derived from the bot token, so a
+// valid initData proves which Telegram user opened the app.
+//
+// See https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxAge is how long initData is accepted after Telegram issued it
+const DefaultMaxAge = 24 * time.Hour
+
+// Telegram's and our clocks may disagree a little
+const maxClockSkew = time.Minute
+
+var (
+	ErrInvalidInitData = errors.New("invalid init data")
+	ErrInitDataExpired = errors.New("init data has expired")
+)
+
+// User is a Telegram user as it appears in initData
+type User struct {
+	ID           int64  `json:"id"`
+	IsBot        bool   `json:"is_bot"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Username     string `json:"username"`
+	LanguageCode string `json:"language_code"`
+	IsPremium    bool   `json:"is_premium"`
+	PhotoURL     string `json:"photo_url"`
+}
+
+// Chat is the chat the Mini App was opened from, only sent for apps opened
+// from the attachment menu
+type Chat struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Username string `json:"username"`
+}
+
+// InitData is validated initData
+type InitData struct {
+	QueryID      string
+	User         *User
+	Receiver     *User
+	Chat         *Chat
+	ChatType     string
+	ChatInstance string
+	StartParam   string
+	AuthDate     time.Time
+}
+
+// Validator checks initData was signed for one bot and is recent
+type Validator struct {
+	secret []byte
+	maxAge time.Duration
+	now    func() time.Time
+}
+
+// NewValidator validates initData for the bot with botToken. initData older
+// than maxAge is refused.
+func NewValidator(botToken string, maxAge time.Duration) *Validator {
+	return &Validator{secret: secretKey(botToken), maxAge: maxAge, now: time.Now}
+}
+
+// Validate checks the hash and auth_date of initData, the raw query string
+// the Mini App got from Telegram.WebApp.initData, and returns its fields
+func (v *Validator) Validate(initData string) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInitData, err)
+	}
+	hash := values.Get("hash")
+	if hash == "" {
+		return nil, fmt.Errorf("%w: missing hash", ErrInvalidInitData)
+	}
+	expected, err := hex.DecodeString(hash)
+	if err != nil || !hmac.Equal(expected, sign(v.secret, values)) {
+		return nil, fmt.Errorf("%w: hash mismatch", ErrInvalidInitData)
+	}
+
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed auth_date", ErrInvalidInitData)
+	}
+	data := &InitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		AuthDate:     time.Unix(authDate, 0).UTC(),
+	}
+	now := v.now()
+	if now.Sub(data.AuthDate) > v.maxAge {
+		return nil, fmt.Errorf("%w: issued at %s", ErrInitDataExpired, data.AuthDate.Format(time.RFC3339))
+	}
+	if data.AuthDate.Sub(now) > maxClockSkew {
+		return nil, fmt.Errorf("%w: auth_date is in the future", ErrInvalidInitData)
+	}
+
+	if err := decodeField(values, "user", &data.User); err != nil {
+		return nil, err
+	}
+	if err := decodeField(values, "receiver", &data.Receiver); err != nil {
+		return nil, err
+	}
+	if err := decodeField(values, "chat", &data.Chat); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// VerifiedUser validates initData and returns the user it was issued to
+func (v *Validator) VerifiedUser(initData string) (*User, error) {
+	data, err := v.Validate(initData)
+	if err != nil {
+		return nil, err
+	}
+	if data.User == nil {
+		return nil, fmt.Errorf("%w: missing user", ErrInvalidInitData)
+	}
+	return data.User, nil
+}
+
+// Sign returns initData for fields signed for the bot with botToken, as
+// Telegram would send it. It is meant for tests and development clients.
+func Sign(botToken string, fields url.Values) string {
+	values := url.Values{}
+	for key, value := range fields {
+		if key != "hash" {
+			values[key] = value
+		}
+	}
+	values.Set("hash", hex.EncodeToString(sign(secretKey(botToken), values)))
+	return values.Encode()
+}
+
+func decodeField(values url.Values, key string, v interface{}) error {
+	raw := values.Get(key)
+	if raw == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), v); err != nil {
+		return fmt.Errorf("%w: malformed %s", ErrInvalidInitData, key)
+	}
+	return nil
+}
+
+// secretKey is the HMAC-SHA256 of the bot token keyed with "WebAppData"
+func secretKey(botToken string) []byte {
+	mac := hmac.New(sha256.New, []byte("WebAppData"))
+	mac.Write([]byte(botToken))
+	return mac.Sum(nil)
+}
+
+// sign is the HMAC-SHA256 of the data-check-string: every field but hash as
+// key=value, sorted by key and joined by newlines
+func sign(secret []byte, values url.Values) []byte {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if key != "hash" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lines = append(lines, key+"="+values.Get(key))
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.Join(lines, "\n")))
+	return mac.Sum(nil)
+}
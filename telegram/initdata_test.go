@@ -0,0 +1,120 @@
+package telegram
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// initData was signed outside this package, following Telegram's documented
+// algorithm: the secret key is HMAC-SHA256(key "WebAppData", botToken) and
+// hash is hex(HMAC-SHA256(key secret, data-check-string)), where the
+// data-check-string is every other field as key=value, sorted and joined by
+// newlines
+const (
+	botToken = "123456789:TEST-bot-token-for-initdata"
+	initData = "query_id=AAHdF6IQAAAAAN0XohDhrOrc" +
+		"&user=%7B%22id%22%3A42%2C%22first_name%22%3A%22Ada%22%2C%22last_name%22%3A%22Lovelace%22%2C%22username%22%3A%22ada%22%2C%22language_code%22%3A%22en%22%2C%22is_premium%22%3Atrue%7D" +
+		"&auth_date=1700000000" +
+		"&hash=9984b46932d4c1b67839819300d95cd54bb356dcd7ad190dcc3beffbb3379fe2"
+)
+
+var authDate = time.Unix(1700000000, 0).UTC()
+
+// newTestValidator validates at now
+func newTestValidator(token string, now time.Time) *Validator {
+	validator := NewValidator(token, DefaultMaxAge)
+	validator.now = func() time.Time { return now }
+	return validator
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("initData signed by Telegram is valid", func(t *testing.T) {
+		data, err := newTestValidator(botToken, authDate.Add(time.Hour)).Validate(initData)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "AAHdF6IQAAAAAN0XohDhrOrc", data.QueryID)
+		assert.Equal(t, authDate, data.AuthDate)
+		assert.Equal(t, &User{ID: 42, FirstName: "Ada", LastName: "Lovelace", Username: "ada", LanguageCode: "en", IsPremium: true}, data.User)
+	})
+
+	t.Run("a tampered field fails the hash", func(t *testing.T) {
+		tampered := strings.Replace(initData, "%22id%22%3A42", "%22id%22%3A43", 1)
+
+		_, err := newTestValidator(botToken, authDate).Validate(tampered)
+
+		assert.ErrorIs(t, err, ErrInvalidInitData)
+		assert.ErrorContains(t, err, "hash mismatch")
+	})
+
+	t.Run("an added field fails the hash", func(t *testing.T) {
+		_, err := newTestValidator(botToken, authDate).Validate(initData + "&start_param=bonus")
+
+		assert.ErrorIs(t, err, ErrInvalidInitData)
+	})
+
+	t.Run("another bot's token fails the hash", func(t *testing.T) {
+		_, err := newTestValidator("987654321:another-bot-token", authDate).Validate(initData)
+
+		assert.ErrorIs(t, err, ErrInvalidInitData)
+		assert.ErrorContains(t, err, "hash mismatch")
+	})
+
+	t.Run("initData without a hash is refused", func(t *testing.T) {
+		withoutHash := initData[:strings.Index(initData, "&hash=")]
+
+		_, err := newTestValidator(botToken, authDate).Validate(withoutHash)
+
+		assert.ErrorIs(t, err, ErrInvalidInitData)
+		assert.ErrorContains(t, err, "missing hash")
+	})
+
+	t.Run("stale initData is refused", func(t *testing.T) {
+		validator := newTestValidator(botToken, authDate.Add(DefaultMaxAge))
+		_, err := validator.Validate(initData)
+		assert.NoError(t, err)
+
+		validator = newTestValidator(botToken, authDate.Add(DefaultMaxAge+time.Second))
+		_, err = validator.Validate(initData)
+		assert.ErrorIs(t, err, ErrInitDataExpired)
+	})
+
+	t.Run("initData from the future is refused", func(t *testing.T) {
+		_, err := newTestValidator(botToken, authDate.Add(-2*maxClockSkew)).Validate(initData)
+
+		assert.ErrorIs(t, err, ErrInvalidInitData)
+	})
+
+	t.Run("Sign matches Telegram", func(t *testing.T) {
+		validator := newTestValidator(botToken, authDate)
+		expected, err := validator.Validate(initData)
+		assert.NoError(t, err)
+
+		fields := url.Values{
+			"query_id":  {"AAHdF6IQAAAAAN0XohDhrOrc"},
+			"user":      {`{"id":42,"first_name":"Ada","last_name":"Lovelace","username":"ada","language_code":"en","is_premium":true}`},
+			"auth_date": {"1700000000"},
+		}
+		signed := Sign(botToken, fields)
+
+		assert.Contains(t, signed, "hash=9984b46932d4c1b67839819300d95cd54bb356dcd7ad190dcc3beffbb3379fe2")
+		data, err := validator.Validate(signed)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, data)
+	})
+}
+
+func TestVerifiedUser(t *testing.T) {
+	validator := newTestValidator(botToken, authDate)
+
+	user, err := validator.VerifiedUser(initData)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), user.ID)
+
+	withoutUser := Sign(botToken, url.Values{"auth_date": {"1700000000"}})
+	_, err = validator.VerifiedUser(withoutUser)
+	assert.ErrorIs(t, err, ErrInvalidInitData)
+}